@@ -1,16 +1,28 @@
 // Code generated by go-bindata.
 // sources:
 // artifacts/backupinstance-crd.yaml
+// artifacts/backupinstance-job-cleanup.yaml
 // artifacts/backupinstance-job-create.yaml
 // artifacts/backupinstance-job-delete.yaml
+// artifacts/backupinstance-job-snapshot.yaml
 // artifacts/backupschedule-crd.yaml
 // artifacts/backupschedule-cronjob.yaml
 // artifacts/backupschedule-pvc.yaml
+// artifacts/cluster-configmap-config.yaml
+// artifacts/cluster-configmap-init.yaml
+// artifacts/cluster-configmap-proxysql.yaml
 // artifacts/cluster-configmap.yaml
 // artifacts/cluster-crd.yaml
+// artifacts/cluster-job-migration.yaml
+// artifacts/cluster-proxy-deployment.yaml
+// artifacts/cluster-service-metrics.yaml
+// artifacts/cluster-service-proxy.yaml
 // artifacts/cluster-service-read.yaml
 // artifacts/cluster-service.yaml
+// artifacts/cluster-servicemonitor.yaml
 // artifacts/cluster-statefulset.yaml
+// artifacts/cluster-validatingadmissionpolicy.yaml
+// artifacts/cluster-validatingadmissionpolicybinding.yaml
 // DO NOT EDIT!
 
 package artifacts
@@ -78,7 +90,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _artifactsBackupinstanceCrdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x4b\x4e\xc4\x30\x0c\x86\xf7\x39\x85\x2f\x40\x50\x77\x28\x4b\x60\x83\x18\x90\x00\x89\xbd\x9b\x9a\x8e\xd5\xbc\x88\x9d\x8a\xb9\x3d\x6a\x0a\x9a\xcd\x20\x76\x79\x7c\xfe\x1f\x32\x16\x7e\xa7\x2a\x9c\x93\x03\x2c\x4c\x5f\x4a\x69\xbb\x89\x5d\x6e\xc4\x72\xbe\x5e\x87\x91\x14\x07\xb3\x70\x9a\x1c\xdc\x35\xd1\x1c\x5f\x49\x72\xab\x9e\xee\xe9\x83\x13\x2b\xe7\x64\x22\x29\x4e\xa8\xe8\x0c\x40\xc2\x48\x0e\xe2\x49\x3e\xc3\x88\x7e\x69\x85\x93\x28\x26\x4f\x62\x7d\xb5\xfd\x3d\x17\xaa\xa8\xb9\xda\xb9\x6a\xb0\x33\xeb\xb1\x8d\xd6\xe7\x68\xa4\x90\xdf\x34\xe6\x9a\x5b\x71\xf0\x2f\xbf\xbb\xc9\x36\x02\xb0\x67\x7c\x3a\xbd\xbd\x1c\x6e\xbb\xf1\xc3\x8f\x71\xff\x0d\x2c\xfa\xf8\x17\x71\x60\xd1\x4e\x09\xa7\xb9\x05\xac\x17\x0b\x74\xa2\x84\x56\x31\x5c\x2e\xb8\x4b\x1c\x73\xd5\xe7\x73\xac\x2b\x88\x23\x9f\x4f\x1b\x24\x3e\x17\x72\xd0\xa1\x82\x9e\x26\x03\xb0\xfe\xee\x61\x1d\xcc\x77\x00\x00\x00\xff\xff\x07\x47\x8e\xe5\x97\x01\x00\x00")
+var _artifactsBackupinstanceCrdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x91\xcb\x4e\xc4\x30\x0c\x45\xf7\xf9\x8a\xfc\x00\x41\xdd\xa1\x2c\x81\x0d\x62\x40\x02\x24\xf6\x6e\x6a\x3a\x56\xf3\x22\x76\x2a\xe6\xef\x49\x53\xd0\x6c\x06\xb1\xf3\xe3\xd8\xd7\x57\x86\x4c\xef\x58\x98\x52\xb4\x1a\x32\xe1\x97\x60\xdc\x32\x36\xcb\x0d\x1b\x4a\xd7\xeb\x30\xa2\xc0\xa0\x16\x8a\x93\xd5\x77\x95\x25\x85\x57\xe4\x54\x8b\xc3\x7b\xfc\xa0\x48\xd2\x70\x15\x1a\x34\x81\x80\x55\x5a\x47\x08\x68\x75\x38\xf1\xa7\x1f\xc1\x2d\x35\x53\x64\x81\xe8\x90\x8d\x2b\xa6\xd7\x53\xc6\x02\x92\x8a\x99\x8b\x78\x33\x93\x1c\xeb\x68\x5c\x0a\x8a\x33\xba\x6d\xc7\x5c\x52\xcd\x56\xff\xcb\xef\x6a\xbc\x8d\x68\xbd\xdf\xf8\x74\x7a\x7b\x39\xdc\x76\xe1\x87\x1f\xe1\xde\xf5\xc4\xf2\xf8\x17\x71\x68\xcd\x4e\x31\xc5\xb9\x7a\x28\x17\x0d\x74\x22\xfb\x5a\xc0\x5f\x36\xb8\xaf\x38\xa6\x22\xcf\xe7\xb3\xae\x74\x18\xe9\x1c\x6d\x10\xbb\xe6\xc8\xea\x0e\x65\x70\x38\xb5\xda\xfa\xfb\x87\x75\x50\xdf\x07\x47\x8e\xe5\x97\x01\x00\x00")
 
 func artifactsBackupinstanceCrdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,7 +110,27 @@ func artifactsBackupinstanceCrdYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsBackupinstanceJobCreateYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\x4d\x6b\x1b\x31\x10\xbd\xef\xaf\x18\xb6\x05\x9f\x64\x3b\xe4\x50\x10\xe4\xd0\x6e\x28\xb4\x25\xa9\x69\x68\xae\x65\xac\x9d\xd8\xc2\xfa\x8a\x34\x1b\x6c\x9c\xfd\xef\x45\xbb\x76\x2c\xa7\x29\x3d\xd5\x07\xb3\x7a\xf3\x46\xef\x49\x6f\x84\x41\xdf\x53\x4c\xda\x3b\x09\x4b\x64\xb5\x9e\x3d\x5d\x54\x1b\xed\x5a\x09\x5f\xfd\xb2\xb2\xc4\xd8\x22\xa3\xac\x00\x1c\x5a\x92\xb0\xdf\xe7\x42\x13\x09\x99\x6e\xd1\x12\x4c\x87\xff\xbe\xaf\x52\x20\x95\x79\x4c\x36\x18\x64\xca\xdf\x00\x47\x34\xff\x22\x25\xc6\xc8\x0b\x6f\xb4\xda\x49\xf8\xee\x3e\xa3\x36\x5d\xa4\x43\xf9\xc9\x9b\xce\x52\x3a\xb2\xc5\x41\x71\x89\x6a\xd3\x85\x03\x08\x10\xb2\xdd\xc4\xe4\xf8\x7e\xe0\x37\x06\xb5\x95\x2f\x65\x00\x95\x81\xdb\xa3\xd9\xc5\x7d\x33\xda\xbc\x0b\xa4\xa6\x77\x6a\x4d\x6d\x67\x06\xbf\xe7\x2a\xca\xbb\x87\x97\x4d\xc8\x06\xde\x5d\xeb\x28\x61\xff\x16\x4f\xaf\x84\xc5\x93\xa3\x11\xba\xc1\x50\xba\x18\xd9\x76\x97\x1e\x4d\xf5\x42\x63\xd4\x8e\xe2\xbf\x4e\xa8\x2d\xae\x48\xc2\x2a\xb2\x99\x6d\x39\xe2\x58\x96\xf9\x4e\x13\x17\xaa\xd6\xa2\x6b\x4f\x9a\x02\x96\x98\xd6\xc5\xb2\x16\xaa\x2e\x96\xcf\x85\xbb\x44\x0c\x82\xb6\x55\x01\x6d\xba\x25\x29\x36\x10\xf2\x14\x8c\xc6\x47\x61\xed\x12\xa3\x53\x94\x6f\xf3\x18\x36\x08\xc1\xbb\x40\x57\x13\x4b\x71\x45\x13\x10\x62\x6c\x9b\xec\xeb\xc4\xc8\x5d\xaa\x25\xec\xeb\xb0\xc6\x44\xb5\x84\xfa\x2e\xc7\x4e\x6d\xdd\xf7\x93\x52\xf2\xd3\xc7\xe6\xdb\xcf\xc5\xaf\xeb\x2f\x3f\xae\xea\xd9\xa0\x38\x1b\x25\x67\x85\x54\x5d\x76\xbc\x83\xc6\x78\x47\x90\x67\x12\x1e\xa2\xb7\x60\x31\x31\xc5\x69\xc1\xb1\x9b\x56\x47\x10\x01\xde\xef\x4f\x02\x7d\x19\x8d\x42\x06\x21\x22\xa9\x27\xe1\x9d\xd9\x0d\x07\x1b\xc6\xa3\x31\x5d\xde\x0d\xfa\x5e\xcc\xa7\x6f\xa0\x70\x79\x39\xff\x00\xcf\xb0\x5d\x26\x8e\x84\x16\xc4\x16\x44\xf3\x4a\xe8\xcc\xee\x22\x52\xc0\x48\xc0\x6b\x3a\xc4\x5c\x5a\x3d\xa5\x9b\x6f\xf0\x40\x15\x82\x31\xae\x88\x45\xab\xe3\xd5\x5f\xb7\xfe\x9f\x71\x35\xde\x06\x43\xc7\xc0\x8e\x8a\xe3\xf3\xbc\xf1\x9d\xe3\x54\x4e\xdd\x9b\x33\x0c\x60\x33\x71\x81\xbc\x96\x70\x9e\xed\x1f\xad\x67\x4f\xef\xbc\x91\x58\x1d\x9a\x33\x69\xda\x16\xb4\x48\xc9\x77\x51\x51\x2a\x5f\x5d\x86\x1f\x3b\x4a\xfc\x0a\x05\x50\xa1\x93\x70\x31\x9f\xdb\x57\xb8\x25\xeb\xe3\x6e\x28\xdd\xe8\xea\x77\x00\x00\x00\xff\xff\x96\xba\x06\xc9\x0f\x05\x00\x00")
+var _artifactsBackupinstanceJobCleanupYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x50\xcb\x6a\xc3\x30\x10\xbc\xfb\x2b\x96\xdc\x1d\xd3\xab\xae\x86\x1e\x0a\x4d\x03\x01\xdf\xd7\xf2\xb6\x16\xd1\x2b\xd2\x2a\xd4\x84\xfc\x7b\x2d\xbf\x30\xa1\x3a\x2c\xda\xd9\x61\x76\x76\xd0\xab\x86\x42\x54\xce\x0a\x68\x91\x65\x5f\xdd\xdf\x8a\xab\xb2\x9d\x80\x0f\xd7\x16\x86\x18\x3b\x64\x14\x05\x80\x45\x43\x02\x1e\x8f\x3c\xa8\x35\xa1\x4d\xfe\x34\x42\x70\x9c\xea\xf3\x59\x44\x4f\x32\x13\x99\x8c\xd7\xc8\x94\xff\x00\x2b\x9a\x5f\xa0\xc8\x18\xf8\xec\xb4\x92\x83\x80\x2f\xfb\x8e\x4a\xa7\x40\xcb\xf8\xee\x74\x32\x14\x57\x76\xb9\xac\x6c\x51\x5e\x93\x5f\x40\x00\x9f\xfd\x46\x26\xcb\xcd\xc4\xaf\x35\x2a\x23\xb6\x31\x80\xcc\xc0\x69\x75\x7b\x6e\xea\xd9\xe6\x65\x34\x72\xbc\xc8\x9e\xba\xa4\x27\xbf\x0b\xdb\x59\x46\x65\x47\xd1\xd7\xbd\x72\x3e\x72\x53\x56\x06\x7f\xb2\x9d\x14\x87\xd6\xfd\x6e\xb0\x74\xc6\xe0\x18\xd8\x06\x94\x10\xcc\xae\x39\x94\xe1\xfb\xb0\xeb\x2b\x33\xc4\x9b\xae\xe6\xab\xaa\xd1\xe1\x16\xe0\xca\x99\x73\xf8\x74\xc9\x72\xdc\xcb\xfe\x1b\x07\x80\xc9\xc4\x33\x72\x2f\x5e\xb4\x8b\x3f\x24\x39\xcd\x11\xde\x01\x00\x00")
+
+func artifactsBackupinstanceJobCleanupYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsBackupinstanceJobCleanupYaml,
+		"artifacts/backupinstance-job-cleanup.yaml",
+	)
+}
+
+func artifactsBackupinstanceJobCleanupYaml() (*asset, error) {
+	bytes, err := artifactsBackupinstanceJobCleanupYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/backupinstance-job-cleanup.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsBackupinstanceJobCreateYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x58\x6d\x4f\xe3\x46\x10\xfe\xce\xaf\x18\xb9\x48\x48\x15\x76\xee\x0e\xb5\x95\x52\xe5\x24\x2e\x04\x89\x1e\x90\x34\x09\x54\xa7\x52\xa1\x8d\xbd\x49\x56\xac\xbd\x66\x77\x1d\x88\x38\xfe\x7b\x67\xbc\x36\x59\x3b\x29\xa8\xa7\x6b\xf9\xc0\xe1\xf5\xbc\x3e\x33\xf3\xec\xf8\x58\x2e\xae\xb9\x36\x42\x65\x5d\x98\x31\x1b\x2f\x3b\xab\xf7\x7b\x77\x22\x4b\xba\xf0\x9b\x9a\xed\xa5\xdc\xb2\x84\x59\xd6\xdd\x03\xc8\x58\xca\xbb\xf0\xf4\x44\x2f\xfa\x9a\x33\xcb\x2f\xf1\x04\xa2\xf2\xf7\xf3\xf3\x9e\xc9\x79\x4c\x72\x33\x16\xdf\xa9\xf9\xfc\x5c\xa4\xc2\x96\xf2\xd1\x27\xef\x84\x24\x01\x58\x6c\xc5\x8a\x9f\x70\x96\x48\x91\xf1\x09\x8f\x55\x96\x18\x27\x7c\xbc\xeb\x95\xd3\xb2\x3c\xcd\x25\xfa\x25\x2f\x00\xb5\x3f\xfa\xd1\xdc\x58\xa6\xed\x48\x49\x11\xaf\xbb\x30\xcc\x4e\x99\x90\x85\xe6\xd5\xeb\x95\x92\x45\xca\x4d\x2d\x1d\x56\xb9\x50\xa4\x45\x5e\x1d\x02\xe4\x04\x84\xb1\x3c\xb3\xd7\xa5\x7c\x5f\x32\x91\x76\x5f\x5e\x03\xc4\x74\x70\x59\xc3\x30\xba\xee\x3b\x00\x26\x18\x48\x34\x89\x97\x3c\x29\x24\x77\x91\xfa\x5e\x30\x81\xf9\x8b\x11\xcc\xc0\xae\x4f\x84\x46\x0b\xbb\xe4\xc4\x22\x4c\xd9\x26\x22\x77\x74\xc1\x72\x3f\x0a\x27\x9d\xae\xcd\xbd\xdc\x7b\x11\xb3\x0c\xc1\xd2\x6f\x65\x28\x52\xb6\xc0\xd3\x85\xb6\xb2\xf3\x68\x35\x73\xaf\xbb\x84\xa9\xb1\x2f\x52\x98\x9b\x98\x83\xd2\x10\x9d\x65\xb1\x2c\x12\x2a\x82\xe6\x16\xa2\x53\xa5\x53\x66\x37\x19\x62\x3a\xd9\xaa\xdb\xd2\x6b\x29\x79\xc2\x75\x50\x93\x41\x7f\x3c\x98\xde\x0e\x2e\xfb\xe3\x2f\xa3\xe9\xd9\xf0\xf2\xf6\xf3\xe0\x8b\x97\xe0\x8a\xc9\x82\x9f\x6a\xd5\xc0\x1e\xeb\x5d\x1a\xfc\xcc\xd7\x63\x3e\x6f\xbe\xf1\x7a\x33\x1a\x64\xb1\x5e\xe7\x16\x1b\x1a\x25\xb7\x63\x70\x3f\x77\x1c\x9b\x04\x7f\xf9\xa1\xf3\x2c\xf1\x05\xab\x64\xb6\x53\xae\xb3\xb8\xf8\x32\xf9\xfd\xfc\x76\x3c\x1c\x4e\x6f\x47\xc7\x93\xc9\x1f\xc3\xf1\xc9\xf7\xca\xa1\x2f\x0b\x6c\x43\xfd\x6a\xf4\x39\x33\xe6\x41\xe9\xe4\xf5\x14\x5a\x27\xb1\x4a\x53\x86\xb3\xed\x25\x33\x63\x66\xe9\x3d\x06\x61\x1c\x78\x8f\x5f\xf7\xfc\xd8\x2d\x84\xfc\x71\xcf\x3b\xba\x2b\x66\x3c\xb6\x12\x83\x41\xe6\x70\x2d\xe9\x5a\x4a\x64\x38\x8f\x59\xcc\xcb\x74\x2a\x82\x80\x30\xb4\xeb\x9c\xf7\x0e\x52\xae\x17\xfc\x00\x1f\x9d\xda\xc1\x53\x80\xc2\xb6\x30\x01\x66\x1f\xe4\x4b\x66\x38\xfe\x15\x4c\x68\xa0\x79\x12\x3c\x3f\x1f\xf8\x2e\x3f\x1d\xf7\x3f\x5f\x8d\x6e\x4f\xce\xc6\xbd\xa0\x53\x7a\xec\x38\x97\x1d\xcf\x55\xe0\x6b\xfc\x00\x7d\xa9\x32\x0e\xc4\x63\x30\xc7\x92\x80\x5d\x72\x62\x0c\x25\x57\x3c\xa9\x46\x04\x8c\x2a\x74\xcc\x23\x4f\x2f\xbd\x4b\x84\x86\x30\x87\xfd\xa7\x8d\x53\xbf\x16\x59\x8c\x9d\x11\x86\x9a\xc7\xab\x50\x65\x72\x5d\x26\x3b\x29\xed\x1c\x27\x09\x3a\x20\xda\x82\xa3\xa3\x77\xbf\xc0\x57\x78\x9c\x19\x8b\xa4\x99\x42\xf8\x08\x61\xbf\x65\xb3\x11\xed\x48\xf3\x9c\x69\x5e\x46\xe9\x82\xf3\xa3\xda\x8c\x2d\x01\x58\x89\x22\xb2\x0c\x31\xb5\x21\x46\xdc\x7b\xc5\xf4\x18\xd9\x54\x27\x9e\x65\x9c\x72\xb1\x10\x19\x60\xbd\x44\xe2\x5c\x22\xea\x62\x8e\xfc\x7c\x88\x90\x00\x83\x44\x18\x46\xcd\xd8\xb0\x83\x29\xab\x15\xd7\xeb\x92\x77\x15\x06\x10\xb3\x8c\x5a\x5e\x20\xc6\x9c\xba\x0c\x2b\x2b\x66\x42\x0a\xbb\xf6\x63\xe7\xf1\x52\x51\xb9\x63\xd7\xdf\x54\xe5\x0d\x64\x75\xd7\x53\xf9\x0e\x21\xa0\x69\x30\x39\x8b\x79\x4b\xea\xb2\x3e\xaf\xe4\x56\xee\xf6\x6a\x49\x55\x77\x5a\x25\x63\x45\x4a\xf7\x43\x9a\xb7\xa4\xa6\xf5\x39\xc9\x3d\x1f\xc0\xc7\x66\x59\x3c\x8a\xbc\x75\xed\x71\x2b\xb2\xb9\xf2\x12\x7a\x8b\xf1\x08\xab\x41\x3a\xe3\x0e\xf2\x2a\x6d\x40\xa9\x04\x2f\x19\xc1\xa4\xa9\x08\x01\x27\x8d\xc9\x07\xb6\x36\x38\xb0\x25\x7d\xa1\x46\x58\xe2\xdf\x30\x55\xd7\x62\x37\xfe\x9a\x4c\x11\xfe\xc2\x46\x3b\x66\x14\xdb\xa3\xf6\xb6\x8b\x63\x20\x54\xb0\x66\xa9\x84\x9b\x06\xdb\x7c\x05\x95\xf3\xcc\x18\x49\x91\x41\xc8\xb8\x09\x3f\xfc\xf4\x73\x18\xcf\xf0\x21\x9f\xdd\x25\xf3\x0f\xf8\x2f\x52\x51\x79\x13\xec\x24\xf6\x96\xc1\x36\xc6\x2e\xa4\x88\x5c\x47\xe8\xa2\x09\x6e\x8b\xbe\x6a\xc0\xf9\xfd\x0b\x31\x07\x48\x00\x41\x1b\xf2\x73\xb5\x10\x31\x93\x80\x04\x0d\x49\x81\xe5\x65\x48\x00\x8b\x97\x0e\xcf\x97\x6b\x53\xbe\xaf\xa6\x0b\xce\x2c\x48\x46\x5b\x86\x1b\x83\x86\x2d\x6f\x56\x70\xb8\x10\x49\xa5\xd7\xe5\x68\xd0\x8b\x39\xcb\x42\x55\x58\x98\x71\xa9\x1e\xa0\xc8\xa5\x62\x64\xc5\x82\x55\xea\x6d\x2a\xe9\xf0\xc7\x5c\x69\xeb\xcb\x11\x9f\x95\x11\x87\xcb\xdd\x6c\x12\x16\xa0\x95\xc2\x86\xc9\x83\xfd\xa7\x1d\x57\xd0\x73\xd0\xc2\x3b\x0c\x99\x94\x21\x71\x1f\x32\x3d\x37\xf8\x6c\x44\xb6\x90\x3c\xc4\xde\xce\x0c\xed\x61\x38\x26\x1f\x77\x06\xd6\xa1\x48\xa2\xcd\x9a\x51\x17\x45\x1a\xde\xae\x42\x6c\x56\x5b\x55\x18\x71\x8d\xb4\x34\xc3\x95\xa8\x3f\xb9\x06\x67\xd2\xbc\x59\x8a\xcb\xe9\x10\x86\x57\xd3\xd3\xb3\xf3\x41\xc3\xda\x83\x2a\x64\x02\x0f\x5a\x60\x83\x63\xc4\xa4\x6c\xb8\xc6\x21\x78\x29\x86\x56\x0f\x68\x1e\x87\xc1\xb9\xc2\x11\x8a\xa5\xc0\x39\x0b\xc9\xdb\xb7\x54\x03\x73\x45\x8c\x16\xb8\xe1\x61\x02\x53\xca\xc4\x34\x73\x2c\xcb\x55\x95\x6a\xff\x9b\x6a\x15\x5e\xe2\x95\xda\xaa\x58\x30\x19\x9c\x0f\xfa\x53\xf8\x11\x4e\xc7\xc3\x8b\xb2\x0d\x88\x9f\x70\x14\x0d\xe6\x74\x60\x3a\x37\xb6\x73\xd8\x59\x1c\xfc\x53\xd5\x2a\x85\x08\x6b\xf2\xf6\x34\x55\x67\x0d\xa8\x4f\x59\xd6\xb8\x22\x0a\xea\x67\xe0\x25\xe1\xb8\x8d\x14\x37\xeb\x04\x88\x79\x70\x9d\x84\x04\x39\x48\x64\x8c\x1a\xc9\x47\xf9\xf4\x18\x4b\x78\xd2\x7b\xd7\xf0\x17\xd6\x88\x9e\x6c\x94\x5a\xa0\x62\x67\x6d\xee\xca\x98\xee\xca\xa0\x91\x66\x80\xd9\xd1\x75\x1a\x4b\x55\x24\x90\x17\x74\x05\x57\xa1\xf4\xcc\x11\x3d\x1c\x85\xb3\x22\xbe\xe3\xb6\x57\x72\xfd\xd5\xf8\xbc\x84\x2f\x28\x8b\x54\x2f\x08\xbf\x52\x86\x59\x03\xf7\xf1\x60\x72\x75\x3e\xed\x05\x57\xe5\x18\xe3\xe6\xe1\xdf\x5c\xd8\xf3\x3b\x85\xe9\x3b\xa3\x29\xea\xd2\xc4\x2c\x32\x2c\x7d\xf4\x09\xf3\x1c\xcc\xe7\x58\x97\xf6\x1e\x57\xe1\xf3\x7e\x4b\x75\xab\x4c\x73\xf1\x6f\x57\xae\xfd\xb7\x76\xae\xe0\xe9\xa6\x5a\xba\x6e\x68\xeb\xba\x09\xbc\x22\xd6\x47\xde\x3e\x45\x27\x37\x58\x06\x97\x35\x3e\x3e\xd3\x8e\xb5\x33\xec\x66\x25\xff\xa4\xe2\xb9\x4c\xa9\xdb\x71\x0b\x7b\x07\x7f\xed\xc0\xfe\xbf\x5c\x24\xab\x12\xe1\x1e\x80\xb7\xbd\xc1\x36\xa1\x43\xc7\xd5\xd4\xd7\x0c\x2f\xce\xfb\x42\x50\x47\x7b\x20\x20\xb5\x97\x5a\xb4\x7d\xfa\x81\xf2\x47\x64\xf7\xf7\xcd\xe2\xfc\x4f\x0b\x71\x1f\xd7\x2a\xc9\x6d\x2b\x28\xf7\x69\x7b\xa1\x8a\xcc\x9a\xee\xd6\x47\x4a\xeb\xfb\x0f\x29\x8b\x04\x47\xcc\x2e\xbb\xd0\xdc\x9e\xb7\x54\x1b\x9f\xad\x4d\x45\x6e\xe3\x4a\x99\x84\xa2\xcd\x37\x08\x2d\xd5\x44\x82\xc6\xff\xb6\x21\x78\x11\x58\xd3\xdd\xea\x74\xc7\x05\xfb\xe4\xef\x10\xf6\xef\x0b\x86\x4b\x91\x5d\x43\xb7\x07\xd1\xb8\xb6\x84\x7f\x39\xf5\xf6\xfc\x50\x9b\x67\x0e\xc8\xf2\xab\x69\xa3\xbf\x25\x18\xee\xa2\xbe\x72\x46\x3d\x3f\xe5\xff\x4e\xb4\xbc\xc8\xf2\xec\x1b\x03\xdf\x65\xf0\x7b\x84\x5d\x1d\xfe\x0d\x17\xe8\xd3\x45\xba\x11\x00\x00")
 
 func artifactsBackupinstanceJobCreateYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -118,7 +150,7 @@ func artifactsBackupinstanceJobCreateYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsBackupinstanceJobDeleteYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x4f\x6b\xf3\x30\x0c\x87\xef\xf9\x14\xa2\xf7\x36\xbc\x57\x5f\xfb\xb2\xc3\x60\x5d\xa1\x90\xbb\xe2\x88\xc5\xd4\xb2\x3d\x5b\x2e\x0b\xa1\xdf\x7d\x38\x7f\xda\x30\x06\xcb\x21\x58\x3f\x3d\x48\x0f\xc2\x60\x1a\x8a\xc9\x78\xa7\xa0\x45\xd1\x7d\x7d\xfb\x57\x5d\x8d\xeb\x14\xbc\xfa\xb6\x62\x12\xec\x50\x50\x55\x00\x0e\x99\x14\x8c\x63\x69\xfc\x27\x4b\x42\x27\x64\x82\xc3\xf4\xbf\xdf\xab\x14\x48\x17\x4e\x88\x83\x45\xa1\xf2\x06\x58\xd3\xf2\x45\x4a\x82\x51\xce\xde\x1a\x3d\x28\x78\x77\x2f\x68\x6c\x8e\xb4\xb4\x6f\xde\x66\xa6\xb4\xd2\xfb\x65\x63\x8b\xfa\x9a\xc3\x12\x02\x84\xa2\x9b\x84\x9c\x34\x13\x7f\xb4\x68\x58\x3d\xda\x00\xba\x04\xa7\x55\xf6\xdc\x1c\x67\xcd\x4b\x20\x7d\xb8\xe8\x9e\xba\x6c\x27\xdf\x85\xf6\x4e\xd0\x38\x8a\x7f\xed\x35\x8c\x1f\x25\xcd\x69\x68\xfd\xd7\x23\xd6\x9e\x19\x5d\xf7\x14\xd8\x43\xe4\x4d\xb1\xdb\xc7\xdd\xa6\xac\x79\x48\x9f\xb6\x9e\x67\xd7\xe3\xf8\x3c\xdf\xca\xcc\x57\x78\xf3\xd9\x49\xda\x4e\xfd\x55\x0a\x80\x0b\x78\x46\xe9\xd5\x8f\xd9\xd5\x77\x00\x00\x00\xff\xff\x4e\xbc\xa8\xab\xdb\x01\x00\x00")
+var _artifactsBackupinstanceJobDeleteYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x90\x4d\x6b\xc3\x30\x0c\x86\xef\xf9\x15\xa2\xf7\x34\xec\xea\x6b\xc7\x0e\x83\x75\x81\x42\xee\x8a\x23\x16\x53\x7f\xd5\x96\xcb\x42\xe9\x7f\x5f\x9c\x2f\xc2\x18\xcc\x07\x61\xbd\x7a\x79\xf5\x20\xf4\xaa\xa1\x10\x95\xb3\x02\x5a\x64\xd9\x57\xf7\x97\xe2\xaa\x6c\x27\xe0\xdd\xb5\x85\x21\xc6\x0e\x19\x45\x01\x60\xd1\x90\x80\xc7\x23\x0f\x5e\x49\x13\xd3\x79\x54\xe0\x38\xd5\xe7\xb3\x88\x9e\x64\xf6\x31\x19\xaf\x91\x29\xff\x01\x56\x35\xbf\x40\x91\x31\x70\xed\xb4\x92\x83\x80\x4f\xfb\x86\x4a\xa7\x40\xcb\xf8\xee\x74\x32\x14\x57\x77\xb9\x6c\x6c\x51\x5e\x93\x5f\x44\x00\x9f\x71\x23\x93\xe5\x66\xf2\x9f\x34\x2a\x23\xb6\x31\x80\xcc\xc2\x79\x85\xad\x9b\xd3\x8c\x79\x19\x41\x8e\x17\xd9\x53\x97\xf4\xc4\xbb\xb8\x9d\x65\x54\x76\x0c\xfd\x67\xaf\x32\xf8\x95\xd5\x14\x87\xd6\x7d\x6f\xb2\x74\xc6\xe0\x78\xae\x4d\x28\x21\x98\x5d\x73\x28\xc3\x61\xd7\x56\x66\x88\x37\x5d\xcd\xd9\xd5\xc8\xb7\x9d\x6f\xf5\xcc\x57\xf8\x70\xc9\x72\xdc\xa7\xfe\x09\x05\x60\xb2\xb1\x46\xee\xc5\xaf\xec\xe2\x07\x4e\xbc\xa8\xab\xdb\x01\x00\x00")
 
 func artifactsBackupinstanceJobDeleteYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -138,7 +170,27 @@ func artifactsBackupinstanceJobDeleteYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsBackupscheduleCrdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc9\x4e\xc4\x30\x0c\x86\xef\x79\x0a\xbf\x00\x41\x73\x43\x39\x02\x37\x06\x24\x18\x89\xbb\x9b\x9a\xd6\x6a\x36\x62\xa7\x62\xde\x1e\x35\x65\xb9\x0c\xe2\x96\xe5\xf3\xbf\xc8\x58\xf8\x95\xaa\x70\x4e\x0e\xb0\x30\x7d\x28\xa5\xed\x26\x76\xb9\x11\xcb\xf9\x7a\x3d\x0c\xa4\x78\x30\x0b\xa7\xd1\xc1\x5d\x13\xcd\xf1\x85\x24\xb7\xea\xe9\x9e\xde\x38\xb1\x72\x4e\x26\x92\xe2\x88\x8a\xce\x00\x24\x8c\xe4\x20\x9e\xe5\x3d\x0c\xe8\x97\x56\xc4\xcf\x34\xb6\x40\x62\x7d\xb5\xfd\x3d\x17\xaa\xa8\xb9\xda\xa9\x6a\xb0\x13\xeb\xdc\x06\xeb\x73\x34\x52\xc8\x6f\x1a\x53\xcd\xad\x38\xf8\x97\xdf\xdd\x64\x1b\x01\xd8\x33\x3e\x9e\x4f\xcf\xc7\xdb\x6e\x7c\xfa\x32\xee\xbf\x81\x45\x1f\xfe\x22\x8e\x2c\xda\x29\xe1\x34\xb5\x80\xf5\x62\x81\x4e\x94\xd0\x2a\x86\xcb\x05\x77\x89\x39\x57\x7d\xfa\x8d\x75\x05\x71\xf8\x39\x6c\x88\xf8\x5c\xc8\x41\x47\x0a\x7a\x1a\x0d\xc0\xfa\xbd\x85\xf5\x60\x3e\x03\x00\x00\xff\xff\x89\xa9\x99\xc4\x95\x01\x00\x00")
+var _artifactsBackupinstanceJobSnapshotYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x55\x5f\x6f\xda\x30\x10\x7f\xe7\x53\xdc\xb2\x6a\x6c\xd2\x42\xdb\x4d\x9b\xa6\xd0\x3e\x30\xa0\x6a\x57\xd6\x50\x42\xa9\x26\x4d\xaa\x4c\x72\x40\x86\x13\xa7\xb6\xc3\x8a\x80\xef\xbe\x73\x08\x91\xe9\xca\xdb\x34\x1e\x20\x3e\x5f\xce\x77\xbf\x3f\x86\x65\xf1\x08\xa5\x8a\x45\xea\xc1\x98\xe9\x70\x76\xbc\x38\xad\xcd\xe3\x34\xf2\xe0\x9b\x18\xd7\x12\xd4\x2c\x62\x9a\x79\x35\x80\x94\x25\xe8\xc1\x6a\x65\x36\x82\x94\x65\x6a\x26\xf4\x0d\xc5\xa0\x51\x7c\x6f\x36\x35\x95\x61\x68\x32\x35\x26\x19\x67\x1a\xcd\x33\xc0\x2e\x6a\x3e\x12\x95\x66\x52\xf7\x05\x8f\xc3\xa5\x07\x7e\x7a\xc1\x62\x9e\x4b\x2c\xb7\x43\x91\x6a\x16\xa7\xd4\xd0\xee\x05\xb7\x3c\x76\xcc\xc2\x79\x9e\x95\x41\x80\x38\x61\x53\x8a\x4e\xa5\xe6\xc7\x4f\x5a\xb2\xed\xb6\x67\x0e\x55\xba\xca\xc2\x74\xe1\x55\x8b\x5d\xa5\xef\x3f\x82\xdb\xde\xc3\xc0\xf7\x87\x0f\xfd\x56\x10\xdc\xfb\x83\x4e\x95\x03\xb0\x60\x3c\xc7\x0b\x29\x12\xcf\x0a\xd2\x0c\x18\x4a\xd4\xd7\xb8\x1c\xe0\x64\x7f\xc7\xc2\xa5\x11\x14\x59\x06\x89\xfd\x8c\x39\xd2\xac\x19\x53\xea\xb7\x90\x51\xb5\x17\x8a\x24\x61\x04\xb4\xd5\xe1\x98\xa9\x99\xb5\x74\xdc\xd0\xb1\x96\xeb\x9a\xdd\x90\x06\x17\x9f\x6a\x56\x68\x9e\x8f\x31\xd4\x9c\x0e\x22\x1a\x21\x59\xaa\x47\xbe\x85\x25\x4e\x09\xf4\x34\xc4\xa2\xc7\x92\x2b\x70\x5d\xbd\xcc\xf0\xbc\x9e\xa0\x9c\x62\x9d\x96\xdb\xd7\xea\x2b\x87\x92\x75\xae\x1c\x1a\xc9\xc9\x66\x4c\x21\x3d\x39\x81\x61\x0d\x23\x67\xb3\xa9\xdb\x47\xbe\x86\xdb\x3c\x46\x45\xa5\xf5\x0c\x89\xdc\x8c\x68\x65\x1e\x28\x2d\xb2\xdd\x4a\x93\xb4\x80\xc6\x84\x99\xe0\x11\x30\x98\x72\x31\x66\x9c\x76\x59\x04\x5c\x84\xf3\xbd\x6a\x31\xa5\x16\x54\x4f\xa5\xc8\xe9\x1d\x85\xca\x48\x13\x26\x42\x16\x27\x44\xb9\xdc\x16\x14\x93\x62\xad\x4a\x19\x36\xac\x2a\xc5\xe0\xe0\xce\xb6\x8c\x88\x5c\x86\xd8\x8a\x22\x12\x9e\x2a\xc6\xce\x9c\xa3\xd5\x0b\x12\xd8\x38\xf0\x73\x8f\x35\x17\x69\xea\xa1\xdf\x87\xa0\xd7\x1a\x75\x9b\x70\xd1\xbb\x0b\x2e\x61\xd8\xfa\xda\xeb\x06\x70\x7f\x35\xbc\x84\x41\xb7\xd5\x81\x9e\xdf\xbe\x6e\x42\xc7\xa7\xb4\x6e\xb7\xff\xf6\xe3\xe7\x93\x93\x77\x4d\x07\xde\x58\xb5\x4c\xca\x43\xff\xaa\x73\x7e\xf4\xca\x26\x90\x23\x66\xf0\xc9\x46\x93\xc0\x82\xb3\x33\xe8\xfa\x17\xb0\xae\xd8\x24\x45\x91\xa8\xc1\x9d\x80\x6b\xa5\x32\xcb\xb6\x15\x08\x04\xbb\x24\x5b\x34\xe6\x5f\x54\x23\x16\x64\x64\xc6\x89\xc0\x53\x5b\x22\x85\xb1\x47\x82\xe7\x09\xee\x2c\x6c\x43\x67\xb9\xfd\x05\x7d\xbf\x6c\x7a\x6b\x24\xcb\xe8\x65\xa4\x80\xff\xb9\x61\xb6\x5d\xf4\x4d\xff\x4a\x63\xaa\xb7\xfd\xb4\x39\x8b\x93\x83\xd6\xea\x8f\xda\x7f\x1f\x48\x48\xed\xab\xf1\x9e\xc5\xba\x12\xcb\x0e\x17\xd0\x02\xc6\x08\x61\xae\xe9\x87\x36\x8d\x50\x55\x9e\xc4\xe9\xd4\xd6\xa8\x2d\xa1\x3c\xd5\x31\xaf\x18\x98\x92\xd5\x16\x45\x8b\x55\xc5\x43\x60\x3c\xd7\x90\x80\x5f\x4a\xa4\xe4\xac\xd9\x79\x7d\xd5\xd8\x1a\xab\x61\xa4\xbf\x1c\x8a\x3b\x85\x9b\x3a\x11\x3d\xa5\x26\xc0\x7d\x04\x2d\x73\x6c\x42\x24\xf6\x01\x2c\x54\xf2\xc1\x8a\x45\x22\xc5\xfd\xa1\x07\x66\x1a\x82\x49\x22\x47\xb2\x6b\x69\xc5\xd2\x5e\x85\xf5\xca\x5b\xf7\xd0\xb8\xf3\x98\x73\x38\x5a\xed\x74\xba\x81\xf5\xba\xe8\xe6\x5f\x98\xca\xd8\xe8\xee\xc6\x94\x2e\xbd\xd3\x84\x60\xd8\x1a\x0c\x4b\x5b\x39\xff\xeb\x02\x6b\x0b\xfa\x4b\x42\x73\x85\xbd\x07\x47\x59\xdc\x99\xcd\x43\x74\x16\xd7\xdd\x1f\xd8\x32\x79\x7e\x22\x07\x00\x00")
+
+func artifactsBackupinstanceJobSnapshotYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsBackupinstanceJobSnapshotYaml,
+		"artifacts/backupinstance-job-snapshot.yaml",
+	)
+}
+
+func artifactsBackupinstanceJobSnapshotYaml() (*asset, error) {
+	bytes, err := artifactsBackupinstanceJobSnapshotYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/backupinstance-job-snapshot.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsBackupscheduleCrdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x91\xcb\x4e\xc4\x30\x0c\x45\xf7\xfd\x8a\xfc\x00\x41\xdd\xa1\x2c\x07\x76\x0c\x48\x30\x12\x7b\x37\x35\x6d\xd4\xbc\xb0\x9d\x6a\xe6\xef\x49\x53\x1e\x9b\x41\xec\xfc\x38\xf6\xf5\x95\x21\xbb\x37\x24\x76\x29\x1a\x05\xd9\xe1\x59\x30\x6e\x19\xeb\xe5\x8e\xb5\x4b\xb7\x6b\x3f\xa0\x40\xdf\x2d\x2e\x8e\x46\xdd\x17\x96\x14\x5e\x91\x53\x21\x8b\x0f\xf8\xee\xa2\x93\x8a\x77\xa1\x42\x23\x08\x98\x4e\xa9\x08\x01\x8d\x0a\x17\xfe\xf0\x03\xd8\xa5\x64\xb6\x33\x8e\xc5\x23\x6b\x4b\xba\xd5\x53\x46\x02\x49\xa4\x27\x12\xaf\x27\x27\x73\x19\xb4\x4d\xa1\xe3\x8c\x76\xdb\x31\x51\x2a\xd9\xa8\x7f\xf9\x5d\x8d\xb7\x11\xa5\xf6\x1b\x9f\x2e\xa7\x97\xe3\xa1\x09\x9f\xbe\x84\x5b\xd7\x3b\x96\xc7\xbf\x88\x63\x6d\x36\x8a\x5d\x9c\x8a\x07\xba\x6a\xa0\x11\xd9\x17\x02\x7f\xdd\xe0\xbe\x62\x4e\x24\xcf\xbf\x67\xdd\xa8\x30\xfc\x04\x1b\xc2\xb6\xfa\x31\xaa\x21\x19\x2c\x8e\xb5\xb6\x7e\x7f\x61\xed\xbb\x4f\x89\xa9\x99\xc4\x95\x01\x00\x00")
 
 func artifactsBackupscheduleCrdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -158,7 +210,7 @@ func artifactsBackupscheduleCrdYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsBackupscheduleCronjobYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x4b\x6f\xd3\x40\x10\xbe\xfb\x57\x8c\x22\x59\x3d\xa0\x75\x94\x1b\xb2\x7a\xa2\x34\xa2\x88\x92\x42\x0a\x12\xb7\x8e\xd7\x93\x78\xc9\x3e\xcc\xee\x38\x10\xb5\xfd\xef\x68\xed\x24\xf2\x83\x4b\x98\x83\x25\x7f\x8f\x9d\x99\xf5\x67\xac\xd5\x77\xf2\x41\x39\x9b\x43\x81\x2c\xab\xf9\x7e\x51\x10\xe3\x22\xd9\x29\x5b\xe6\x70\xe3\x9d\xfd\xe8\x8a\xc4\x10\x63\x89\x8c\x79\x02\x60\xd1\x50\x0e\xcf\xcf\x27\xf2\x33\x1a\x82\xac\x7d\xbe\xbe\x26\x00\xee\xb7\x25\xff\x95\x36\xe4\xc9\x4a\x0a\xd1\x02\x20\xa0\x3b\xf0\xfe\xb0\xfe\xf2\xe9\x1d\xca\x5d\x53\xaf\x65\x45\x65\xa3\xa9\xe5\x01\xfa\xa3\x48\x9f\x99\x43\xf8\xa5\x5d\x4d\x1e\xd9\xf9\x6c\xeb\x59\x67\x5b\xc5\x55\x53\x64\xd2\x99\xf9\x7e\x71\x74\x9d\x87\xe9\x0d\x10\xab\x51\x65\x07\x7f\xbb\x7b\x1f\xd1\x50\x93\x8c\x93\x84\x63\xd3\x1c\x66\x91\x5d\xd7\x24\xb3\x47\xd5\x3a\x67\x09\xc0\x4f\x57\x3c\x92\xa9\x35\x32\x75\x73\x9f\x7c\xb1\x78\xc0\x8c\xd9\x58\x9e\x02\xa3\xe7\x07\xa7\x95\x3c\xe4\xb0\xb2\x4b\x54\xba\xf1\xd4\x93\x48\x67\x19\x95\x25\x1f\xfa\x46\x71\x5c\xa4\x68\x6f\xa6\x47\x00\x28\x83\x5b\xca\x41\xa3\xac\x34\x5a\xda\x93\x0d\xce\xce\x77\x6f\x83\xd8\x35\x05\x49\xd6\x03\xb5\x74\xc6\xa0\x2d\xf3\x01\x28\x20\x54\x23\x60\x26\xe4\x6c\x04\xbd\x0c\xde\x01\x24\x32\x5c\x5f\xc3\xed\x6a\x09\x2f\x70\xec\x05\xd2\x13\x32\x81\xd8\x80\x18\xc9\xff\xef\xfb\x9d\x6a\x92\x8e\x3b\x1b\x18\xad\xa4\x91\xae\x1f\xc4\x61\x9d\x93\x30\xf4\xc7\x58\x3c\x78\xda\xa8\x3f\xe7\x88\x88\xa7\x32\xee\x70\xf5\x26\xfd\x21\x52\x23\xd2\x52\xa4\x1f\x44\x7a\x2f\xd2\xf5\xd5\xd3\xe4\x58\x8d\x05\xe9\x30\x6d\xd7\x8f\xd2\x34\x7e\x83\x7b\xd4\x4d\x60\xf2\x9d\xac\x0d\xdc\x4d\x87\x4c\xe5\xe3\x3c\x5d\xd2\xe8\x92\x36\x8c\xdc\xfc\x63\xa7\xba\xc2\x40\x39\x9c\x7e\xcc\x72\x24\xb8\x5d\x2d\x93\xbf\x01\x00\x00\xff\xff\x64\xc6\xd7\x96\x31\x04\x00\x00")
+var _artifactsBackupscheduleCronjobYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x53\xc1\x4e\xdc\x30\x10\xbd\xef\x57\x8c\x56\x8a\x38\x54\x0e\xe2\x86\x22\x4e\xa5\x45\x50\x41\xa1\x5d\x8a\xd4\x1b\x13\x67\x76\xe3\xae\x63\xa7\xb6\x43\xbb\x02\xfe\xbd\x93\x64\x77\x95\x78\x57\x45\x20\xe6\x10\x29\xef\xcd\xd8\xe3\x37\x6f\xb0\x56\x77\xe4\xbc\xb2\x26\x83\x1c\x83\x2c\x0f\x1f\x8e\x72\x0a\x78\x34\x59\x2a\x53\x64\x70\xea\xac\xf9\x62\xf3\x49\xc5\x58\x81\x01\xb3\x09\x80\xc1\x8a\x32\x78\x7c\xdc\x90\x5f\xf9\x1f\xd2\xee\xfb\xfc\xcc\xbc\xfd\x63\xc8\x7d\xa7\x39\x39\x32\x92\x7c\x5b\x02\x20\xa0\x3f\xf0\x6a\x35\xfb\x76\xf9\x11\xe5\xb2\xa9\x67\xb2\xa4\xa2\xd1\xd4\xf1\x00\x38\x68\x45\xba\xb4\x5a\xf9\xdf\xda\xd6\xe4\x30\x58\x97\x2e\x5c\xd0\xe9\x42\x85\xb2\xc9\x53\x69\x2b\xee\x72\x5d\xb5\x6d\x66\xd0\x40\x1b\x8d\x2a\x7a\xf8\xc7\xc5\xa7\x16\xf5\x35\xc9\xb6\x13\xbf\xbe\x34\x83\x69\xcb\xce\x18\x4e\x6f\x55\x57\x39\x65\xfa\x97\xcd\x6f\xa9\xaa\x35\x06\xea\xfb\xde\xd4\xb5\x11\x46\x4c\xcc\xb6\xe1\xc8\x07\x74\xe1\xc6\x6a\x25\x57\x19\x5c\x9b\x33\x54\xba\x71\x34\x48\x91\xd6\x04\x54\xac\x90\x1f\x16\x8a\xf5\x43\xf2\x4e\x99\x01\x01\xa0\x2a\x5c\x30\xa3\x51\x96\x1a\x0d\x3d\x90\xf1\xd6\x1c\x2e\x8f\xbd\x58\x36\x39\xc9\xa0\x47\xd9\x2c\x4e\x85\x2c\xf4\x08\x14\xe0\xcb\x08\x98\x0a\x39\x8d\xa0\xa7\xd1\x3f\x1f\x85\x01\x4e\x4e\xe0\xf3\xf5\x19\x3c\xc1\xfa\x2e\x9e\x0c\xb1\x02\x20\xe6\x20\xa2\xf4\xb7\xcd\x6f\x13\x3b\xee\xb8\x30\x2c\x25\xfb\x27\xca\x1b\x1a\x71\x1c\x5b\x27\x8c\xeb\x5b\x5b\xdc\x38\x9a\xab\xbf\x5b\x8b\x88\xfb\xa2\x7d\xc3\xc1\x87\xe4\xa7\x48\x2a\x91\x14\x22\x39\x17\xc9\x95\x48\x66\x07\xf7\x3b\xc7\x6a\xcc\x49\xfb\xdd\xeb\x86\x56\xda\xb5\xdf\x48\x47\xdd\xf8\x40\xae\x4f\xeb\x0c\x77\xda\x23\xfb\xd2\xf7\x2e\xcf\x78\x4e\x2f\x2d\xd2\xfb\x0d\xe5\x7f\x0b\x36\x8c\x78\xd9\x22\x9d\xa2\x25\x79\x8d\x7a\xaf\xd0\x8e\xc7\x1d\x9a\x3d\x82\xd5\x25\x7a\xbe\x65\x23\x52\x11\x25\xb0\xbd\x27\xff\x00\x33\x04\x59\x90\x06\x05\x00\x00")
 
 func artifactsBackupscheduleCronjobYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -178,7 +230,7 @@ func artifactsBackupscheduleCronjobYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsBackupschedulePvcYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8d\x31\x8a\xc3\x30\x10\x45\x7b\x9d\xe2\x5f\x60\x0d\xdb\xaa\x75\xed\xc5\xac\xc1\xa9\x07\xe9\x13\x44\x2c\xc9\xd1\xc8\x81\x60\x7c\xf7\xa0\x38\x69\x06\xde\xfc\x07\xef\x16\x92\xb7\x18\x59\x34\x68\x65\xaa\x73\x5e\xb6\xc8\x7e\x91\x10\x8d\xac\x61\x6e\x43\x4e\x16\x8f\x5f\x13\x59\xc5\x4b\x15\x6b\x80\x24\x91\x16\xfb\x8e\x71\xee\xff\x24\x12\xdd\xfb\x1e\x87\xd1\x95\xae\x19\xe2\x1c\x55\x87\xec\xa9\x0d\x81\x1f\xfc\x53\xfc\xa5\x84\xca\x41\xd2\xd3\x00\x85\x9a\xb7\xe2\xbe\x42\xe1\x7d\xa3\xd6\x0f\x01\x5a\x73\x91\xeb\x99\xe9\xa6\x95\xae\x9b\xce\x4f\xcb\xbc\x02\x00\x00\xff\xff\x31\xd3\xda\x23\xb8\x00\x00\x00")
+var _artifactsBackupschedulePvcYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x4d\xcb\x0a\xc2\x30\x10\xbc\xe7\x2b\xf6\x07\x2c\x78\xcd\xb5\xe7\x4a\xb1\x50\xcf\x4b\xb2\x4a\xb0\x49\x6a\x76\x2b\x48\xe9\xbf\x9b\x54\xeb\x41\xc1\xcb\x32\x8f\x9d\x99\xab\x0b\x56\x43\x4b\x89\x1d\x0b\x05\xe9\xe3\x30\x79\xaa\x07\x74\x5e\xe1\xe8\xfa\x62\xc4\xa0\xe1\xbe\x57\x9e\x04\x2d\x0a\x6a\x05\x10\xd0\x93\x86\x79\x86\xb6\xaf\x0f\x19\x43\xb5\xde\x65\x51\x3c\x92\x29\x1f\x68\x0c\x31\x37\xd1\x12\x17\x0a\xb0\x83\x23\xa1\x3d\x25\x27\xd4\x60\x78\x64\x2d\xc7\xdd\x19\xaa\x2e\x27\xaa\x4e\x62\xc2\x4b\x19\x66\xde\xaa\x00\xf8\x4b\x5d\x27\xff\x05\xb2\x4d\xc1\xbe\x70\x22\x8e\x53\x32\xdb\x7e\xa2\xdb\x44\x2c\x6f\xf6\xe9\xfe\xad\x2c\xe9\x27\x67\x72\xc1\xa5\x17\x01\x00\x00")
 
 func artifactsBackupschedulePvcYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -198,7 +250,67 @@ func artifactsBackupschedulePvcYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsClusterConfigmapYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcd\xb1\x0a\xc2\x30\x10\xc6\xf1\x3d\x4f\xf1\x81\x73\x05\xd7\x6c\xe2\xec\xea\x22\x0e\xd7\xe6\xda\x06\x93\x4b\xcc\xc5\x42\xc1\x87\x97\x06\xba\x39\x38\x26\xf7\xbb\xff\x51\xf6\x37\x2e\xea\x93\x58\x2c\x27\xf3\xf4\xe2\x2c\x2e\x49\x46\x3f\x5d\x29\x9b\xc8\x95\x1c\x55\xb2\x06\x10\x8a\x6c\x11\x57\x7d\x05\x03\x04\xea\x39\xe8\xf6\x0f\x50\xce\xfb\x60\xc7\x91\xb4\x72\x39\x0e\x32\x5a\x7c\x1a\x3a\xe0\x9c\x73\x58\x51\x67\xaf\x18\xda\x05\x24\x09\x2b\x92\xa0\xce\xbc\x6f\x34\x7b\x6f\x31\xf7\x68\x8f\x90\xa6\xae\xf7\x62\x00\x0d\xb4\xf0\xbf\xcd\x86\xf5\x47\x4f\xdf\x99\x4b\x57\x98\x5c\xb7\x59\xf3\x0d\x00\x00\xff\xff\x1c\x35\x5e\xba\x03\x01\x00\x00")
+var _artifactsClusterConfigmapConfigYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4d\x4f\x4b\x4f\xc3\x30\x0c\xbe\xf7\x57\x58\xd3\xb8\x41\x10\xd7\x48\x9c\xc6\x05\x89\x21\xb1\x09\xee\x5e\xea\x96\x68\xa9\x93\xb9\x29\x68\x9a\xf6\xdf\xc9\x63\x5b\xc9\x29\xfe\x5e\xf6\x87\xc1\x7e\x91\x8c\xd6\xb3\x86\x9f\xa7\x66\x6f\xb9\xd5\xb0\xf2\xdc\xd9\x7e\x8d\xa1\x19\x28\x62\x8b\x11\x75\x03\xc0\x38\x90\x86\xd3\xe9\x42\xdf\x44\xef\x09\x07\xb5\xa1\xd1\x4f\x62\xa8\x4c\xe7\x73\xd2\x3b\xdc\x91\x1b\xb3\x13\x00\x43\x28\x56\x35\xd3\xfe\x97\x49\x36\xd4\x91\x10\x1b\xba\xe8\x1e\xa0\x5e\xb0\x3e\x6e\x3f\xde\x56\x6e\x1a\x23\x49\x21\x72\xc4\x7c\xa9\x11\x35\x1c\xc7\x83\xf3\x81\x04\xa3\x17\xd5\x4b\x74\xaa\xb7\xf1\x7b\xda\x29\xe3\x87\xc7\x54\xa5\xba\x6e\x47\xff\xdb\x9c\xdf\x64\xdb\x0a\x7f\xbe\xbe\x64\xf4\x5a\x32\x41\x82\xdc\x13\x2c\x3b\xeb\xe8\x1e\x96\xc6\x73\x24\x8e\xa0\x9f\x41\x6d\x03\x19\x55\x7b\xd7\xa4\xa4\x2e\xba\x34\x95\xb4\x20\x96\x63\x07\x8b\xbb\xc3\x62\x76\x5e\x95\xc4\x6d\xfe\xff\x01\x76\x3c\x33\xb0\x72\x01\x00\x00")
+
+func artifactsClusterConfigmapConfigYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterConfigmapConfigYaml,
+		"artifacts/cluster-configmap-config.yaml",
+	)
+}
+
+func artifactsClusterConfigmapConfigYaml() (*asset, error) {
+	bytes, err := artifactsClusterConfigmapConfigYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-configmap-config.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterConfigmapInitYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4d\x4f\xc1\x6e\xc2\x30\x0c\xbd\xf7\x2b\x2c\xa4\x1d\xf1\xb4\x6b\xaf\x70\x41\x1a\x93\x46\xb5\xdd\x4d\xea\x76\xd6\xd2\x24\x38\xe9\x10\x42\xfc\x3b\x69\xba\xd1\xf9\x64\xfb\xf9\x3d\xbf\x47\x41\x3e\x59\xa3\x78\x57\xc3\xcf\x4b\xf5\x2d\xae\xad\x61\xe3\x5d\x27\xfd\x9e\x42\x35\x70\xa2\x96\x12\xd5\x15\x80\xa3\x81\x6b\xb8\x5e\x61\xe7\x24\x35\x46\x25\xa4\xc7\xe1\x5b\xc6\x00\x0f\x1c\xfd\xa8\x86\xcb\x74\xbb\x65\x8e\xa5\x23\xdb\x38\xb1\x01\x28\x84\x42\xc7\x05\xf6\x67\xc7\x7a\xe0\x8e\x95\x9d\xe1\xdf\xbb\x35\xcc\x2e\xf6\x97\xe6\xfd\x75\x63\xc7\x98\x58\x0b\x30\x49\x2c\x6e\x8d\xe2\x70\x89\x27\xeb\x03\x2b\x25\xaf\xd8\x6b\xb2\xd8\x4b\xfa\x1a\x8f\x68\xfc\xf0\x9c\xe3\xcc\xac\x87\xf1\x7f\x9f\xa7\x1a\xa5\x9d\xd7\x1f\xbb\xed\xb4\xfd\x0b\x2a\x39\x1f\x66\xe5\x02\x06\x15\x97\x3a\x58\x3d\x9d\x56\x80\x4d\x60\x83\x4b\xfc\xdc\x5a\x71\x45\xf1\x0e\x07\x77\x24\x52\x48\x01\x00\x00")
+
+func artifactsClusterConfigmapInitYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterConfigmapInitYaml,
+		"artifacts/cluster-configmap-init.yaml",
+	)
+}
+
+func artifactsClusterConfigmapInitYaml() (*asset, error) {
+	bytes, err := artifactsClusterConfigmapInitYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-configmap-init.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterConfigmapProxysqlYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa5\x54\xdb\x6e\x9b\x40\x10\x7d\xcf\x57\x8c\x9c\x3e\x24\x15\xc5\x97\x48\xad\x64\x89\x87\xd4\x76\xd5\x4a\x4e\xed\xda\x4e\xa3\xbe\x14\xad\x61\x80\x55\x16\x96\xec\x2e\x76\x2d\xd7\xff\xde\x59\xf0\x05\x3b\x4e\x5a\xa9\x20\x21\x76\x2e\x67\xce\x9c\x19\x60\x39\xff\x8e\x4a\x73\x99\x75\x61\xd1\xbe\x78\xe4\x59\xd8\x85\x9e\xcc\x22\x1e\xdf\xb1\xfc\x22\x45\xc3\x42\x66\x58\xf7\x02\x20\x63\x29\x76\x61\xbd\x86\xb1\x92\xbf\x56\xfb\x98\xaf\x64\x06\x77\x82\x5a\x16\x2a\xc0\xf2\xb4\xd9\x50\xb8\x60\x73\x14\xda\x26\x02\xb0\x3c\x2f\x33\xdd\x83\x5b\x2e\x33\x54\x13\x8c\x50\x61\x16\xe0\x36\xee\x1d\x54\x04\xee\x56\xd3\x6f\xc3\x9e\x28\xb4\x41\x55\x3a\x2c\xc4\x81\x68\xa0\xdc\x74\xa5\x9f\x84\xcc\x51\x31\x23\x95\x1b\x2b\x23\xdc\x98\x9b\xa4\x98\xbb\x81\x4c\x9b\xd4\x49\x95\xb5\xe7\x5c\xab\x6c\xaf\x82\x87\x95\xf9\xfe\x4b\xdf\x5a\x77\x3d\x5e\xc2\x2c\x41\x08\xca\xde\x80\x6b\x60\x60\x30\xcd\x05\x33\x48\xdc\x0c\xb9\xe8\x3d\xc0\x44\x8a\x10\x95\xf5\x47\x5c\x08\x0c\x81\x67\x10\x29\x99\x96\x11\xc1\x9e\xf6\x25\x68\x0c\x14\x1a\x58\x26\x98\x55\xd9\x56\x39\x0b\x6f\x18\xa7\xf6\x41\x1b\xa6\x8c\x76\x40\x4b\xc8\x24\xe4\x4c\xeb\xa5\x54\xa1\x05\xd6\xd4\x16\x01\x27\x24\x8f\x4b\x50\x65\x22\x75\xec\x06\x59\xe4\x9a\x5c\x74\xe1\x77\xd9\x89\xe5\x1d\x72\xe5\x35\x9a\x0b\xa6\x9a\x82\xcf\x9b\xbb\xc8\x46\x25\x7c\x98\xf2\xcc\x27\x1f\x67\x73\x81\xda\x2b\x8d\xeb\xad\x08\x00\xa5\x8a\x3e\x8f\xa8\x27\xed\x35\xda\x9d\x0f\x6e\x8b\xee\x76\xf7\x7d\xeb\xa6\x53\x01\x54\x82\x55\x71\x2f\xc2\xf0\x8c\x1a\xde\x82\xb4\x4a\x88\x96\x85\xb8\x69\x1c\x0a\xc9\x8c\x53\x47\x7e\xa1\x51\xd9\x99\x78\x0d\x25\xa5\x79\xee\xdf\x49\xe0\x35\xee\x7e\xd0\x0a\xf8\x93\xd1\x68\xe6\x8f\x6f\xa7\xd3\x87\xd1\xa4\xef\x8f\x87\xb7\xbd\xc1\xe7\xd1\xb0\x3f\x98\xd4\xd9\x5d\xc2\x83\xe2\x06\x35\xc4\x12\x8c\xdc\x0a\xcd\x53\xa6\x56\xf4\xae\x64\x11\x27\xa5\x6d\x69\x83\x68\x24\x6a\xc1\x03\x84\xab\x44\x6a\x13\x93\x37\x87\x76\xeb\xda\xd9\x02\x29\x64\xa1\xde\x81\xd8\xc3\x8a\x9e\xb9\xe0\x01\xd3\xf5\x8c\x4e\xeb\xda\x85\x07\xda\x37\x59\x18\xda\x91\xa3\xc0\x23\xa4\x88\x09\x01\x73\x16\x3c\xd6\x31\x77\x1c\xdc\x9a\xb6\xd6\x44\xeb\x5d\x29\x7b\xb5\xd7\x65\x4d\x13\x0c\x15\x6a\x12\x96\xb6\x75\x5a\xe5\x9d\xfd\xe2\x1a\x0e\xe4\x52\x19\xcf\x2e\xf5\x34\xc7\xc0\x1d\xd3\x89\xec\x0e\xec\x69\x7b\xed\x16\x6c\x0e\xd0\x6b\xe0\x11\xb8\x1f\x89\x1c\x66\x44\x75\x73\xe4\x52\x2c\x8b\xf1\xbc\xd7\x39\x61\xe5\x1e\x57\x7f\xf3\x62\xf9\xce\x49\x79\x02\x3e\xa9\x4a\x3f\x0c\x7c\xad\xd4\x84\xd4\xfb\x5f\x11\x5e\x61\x71\x5d\x1b\x88\xdd\xd4\x33\xe3\x38\x59\x60\x07\xfe\x7d\x63\x1d\x08\x31\x62\x85\x30\x7e\x7d\x22\x0e\xb0\xc0\xf0\x05\x7a\x6d\x78\x4e\xe2\xa9\x40\xb5\xf2\x55\xb1\xff\xe6\xea\x54\xac\xd9\xe7\xa1\xd7\x3e\x40\x38\x90\x32\x13\x24\x7e\xc8\x63\xd4\xc6\x6b\xfc\x9c\x0e\x86\x83\xde\xcc\x7d\xfb\x69\x34\x81\xfb\x71\xff\x76\x36\x28\x69\x68\xc3\x33\x66\xe8\x5f\x7a\x4a\x25\xcf\xc5\xca\x32\x71\xce\xd4\xe9\xfc\xb5\xce\x8b\xd8\x9d\x3a\xf6\xb6\xcb\x3f\x29\xf2\xca\x6d\x7a\x06\x00\x00")
+
+func artifactsClusterConfigmapProxysqlYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterConfigmapProxysqlYaml,
+		"artifacts/cluster-configmap-proxysql.yaml",
+	)
+}
+
+func artifactsClusterConfigmapProxysqlYaml() (*asset, error) {
+	bytes, err := artifactsClusterConfigmapProxysqlYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-configmap-proxysql.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterConfigmapYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x8d\x31\x0e\xc2\x30\x0c\x45\xf7\x9c\xc2\x12\x73\x91\x58\xb3\x21\x66\x56\x16\xc4\xe0\x36\x6e\x89\x48\x1c\x13\xa7\x95\x2a\x71\x78\xd2\x48\xdd\x18\xd8\xfc\xbf\x9f\x9f\x51\xfc\x8d\xb2\xfa\xc4\x16\x96\x93\x79\x79\x76\x16\x2e\x89\x47\x3f\x5d\x51\x4c\xa4\x82\x0e\x0b\x5a\x03\xc0\x18\xc9\x42\x5c\xf5\x1d\x6a\x0a\xd8\x53\xd0\xad\x07\x40\x91\x7d\xb1\xc3\x11\xb5\x50\x3e\x0e\x3c\x5a\xf8\x34\xe8\x00\x67\x91\xb0\x42\x79\x7a\x85\xa1\x7d\x80\xc4\xb5\x48\x5c\x3b\xda\x2f\x1a\x7b\x6f\x32\xf7\x68\x21\xa4\xa9\xeb\x3d\xd7\x59\x03\x2e\xf4\xaf\xb3\xc1\xfa\xc3\xa7\xb3\x50\xee\x32\xa1\xeb\x36\xd6\x7c\x01\x1c\x35\x5e\xba\x03\x01\x00\x00")
 
 func artifactsClusterConfigmapYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -218,7 +330,7 @@ func artifactsClusterConfigmapYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsClusterCrdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x50\xbd\x4e\x03\x31\x0c\xde\xf3\x14\x7e\x01\x82\xba\xa1\xac\x65\xa3\x20\x01\x12\xbb\x9b\x9a\xab\xd5\x24\x0e\xb6\x53\xd1\xb7\x47\x77\x27\x40\xc0\xc0\x66\x7f\x3f\xfa\x3e\x1b\x3b\xbf\x90\x1a\x4b\x4b\x80\x9d\xe9\xdd\xa9\xcd\x9b\xc5\xd3\x8d\x45\x96\xeb\xf3\x66\x4f\x8e\x9b\x70\xe2\x76\x48\xb0\x1d\xe6\x52\x9f\xc8\x64\x68\xa6\x5b\x7a\xe5\xc6\xce\xd2\x42\x25\xc7\x03\x3a\xa6\x00\xd0\xb0\x52\x82\x7a\xb1\xb7\x92\xcb\x30\x27\xb5\x98\x35\x2e\x80\x74\x52\x74\xd1\x38\xa9\x97\x38\xb1\x1f\xc7\x3e\x66\xa9\xc1\x3a\xe5\xd9\x3c\xa9\x8c\x9e\xe0\x5f\xfd\x1a\x63\xb3\x05\x60\x2d\x77\x7f\x79\x7e\xdc\x6d\xd7\xc4\x05\x2e\x6c\x7e\xf7\x87\xda\xb1\xf9\x42\x1b\xb7\x69\x14\xd4\x9f\x5d\x17\xaa\x97\xa1\x58\x7e\x1d\xb1\x9a\x8e\xa2\xfe\xf0\x1d\x7d\x05\x35\x7f\x0d\xb3\xc4\xb2\x74\x4a\xb0\x48\x3a\x66\x3a\x04\x80\xf3\xe7\x8b\xcf\x9b\xf0\x11\x00\x00\xff\xff\xdd\x99\x29\xdc\x72\x01\x00\x00")
+var _artifactsClusterCrdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x50\xcb\x4e\x03\x31\x0c\xbc\xef\x57\xf8\x07\x08\xda\x1b\xca\xb5\xdc\x28\x48\x80\xc4\xdd\xcd\x9a\x6d\xd4\xbc\xb0\x9d\x55\xfb\xf7\xcd\x66\x05\x08\x38\x70\x73\xe6\xe1\x19\x07\x8b\x7f\x23\x16\x9f\x93\x05\x2c\x9e\xce\x4a\x69\x7d\x89\x39\xdd\x89\xf1\xf9\x76\x19\x0f\xa4\x38\x0e\x27\x9f\x26\x0b\xbb\x2a\x9a\xe3\x0b\x49\xae\xec\xe8\x9e\xde\x7d\xf2\xda\xe4\x43\x6c\xa2\x09\x15\xed\x00\x90\x30\x92\x85\x78\x91\x8f\xe0\x42\x33\xb4\xfd\xc6\xb1\xe9\x40\x2e\xc4\xa8\x99\xcd\xcc\x1a\xcc\xec\xf5\x58\x0f\xc6\xe5\x38\x48\x21\xb7\x9a\x67\xce\xb5\x58\xf8\x57\xbf\xc5\xc8\x6a\x01\xd8\xca\x3d\x5e\x5e\x9f\xf7\xbb\x2d\xb1\xc3\xc1\x8b\x3e\xfc\xa1\xf6\x0d\xed\xb4\xf8\x34\xd7\x80\xfc\xb3\x6b\xa7\x4a\xa8\x8c\xe1\xd7\x11\x9b\xe9\x98\x59\x9f\xbe\xa3\x6f\x20\xba\xaf\x61\x95\x88\x6b\x9d\x2d\x74\x49\x41\x47\x53\xc3\x96\xcf\x2f\x5e\xc6\xe1\x0a\xdd\x99\x29\xdc\x72\x01\x00\x00")
 
 func artifactsClusterCrdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -238,7 +350,87 @@ func artifactsClusterCrdYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsClusterServiceReadYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xc1\x4e\xc3\x30\x10\x44\xef\xfe\x8a\xfd\x81\x1a\xaa\x4a\x1c\x72\x85\x4b\x25\xa8\x4a\x23\xb8\xbb\xce\x10\x2c\x1c\xdb\xac\x37\x41\x55\xd5\x7f\x47\x76\x02\xf4\x82\x0f\x3e\xec\xcc\xec\x3c\xad\x49\xee\x15\x9c\x5d\x0c\x0d\x4d\x6b\xf5\xe1\x42\xd7\x50\x0b\x9e\x9c\x85\x1a\x20\xa6\x33\x62\x1a\x45\x14\xcc\x80\x86\xce\x67\x3a\xc0\x74\x8b\x61\x67\x06\x90\xae\xff\xe5\xa2\x88\xbc\x39\xc2\xe7\xe2\x26\x32\x29\x55\xfb\x95\x1c\xbf\x02\xf8\x80\x37\x30\x82\xc5\xe2\x5b\xd1\xdc\xf9\x74\x6a\x9f\x1f\xef\xfd\x98\x05\x5c\x85\xb2\xe2\x8f\xcd\xb2\x1e\x4e\xf9\xd3\xc7\x04\x36\x12\x59\xf7\x2c\x5e\xf7\x4e\xde\xc7\xa3\xb6\x71\xb8\x99\xd6\x4b\xea\x17\xf4\xaa\xb9\xbc\xd1\x75\xf3\xf8\x65\xfb\x50\xa6\x39\xc1\x16\x84\x14\x59\x2a\xcb\x6a\x89\xd6\x9e\x1a\x2a\xd2\x9c\x69\x13\xac\xde\x47\x96\x9f\x7d\x62\xb8\x87\xec\xab\x61\xb3\xb9\xbd\x53\x44\x76\x86\xdf\xee\x1b\xda\xc5\x00\x45\x94\xe1\x61\x25\xf2\x3f\x17\xf9\x0e\x00\x00\xff\xff\xa3\xea\x58\x65\x7c\x01\x00\x00")
+var _artifactsClusterJobMigrationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x52\x4d\x4f\xe3\x30\x10\xbd\xf7\x57\x8c\xb8\xd7\x2b\xc4\xcd\x37\x04\x5a\x3e\x4b\x4a\x02\xac\xf6\x84\x26\xce\xb4\x58\x8d\xed\x60\x3b\x41\x15\xe2\xbf\x63\xc7\x6d\x48\x21\xcc\xc9\x79\xf3\xe6\xcd\x9b\xc9\x60\x23\x9f\xc8\x3a\x69\x34\x87\x12\xbd\x78\xf9\xd3\x1d\xcf\x36\x52\x57\x1c\xae\x4d\x39\x53\xe4\xb1\x42\x8f\x7c\x06\xa0\x51\x11\x87\xf7\x77\x58\xc8\xb5\x45\x1f\x4a\x02\xe3\x2e\x80\xc0\x72\x72\xa6\xb5\x82\xfa\xaf\x8f\x8f\x40\xae\xb1\xa4\xda\xc5\x32\x00\x6c\x9a\xbe\x8e\x7d\xa5\xcd\x9b\x26\x9b\xd3\x8a\x2c\x69\x41\x3b\xde\x1c\x52\xe3\xc5\xb6\xb8\xbf\x3d\xab\x5b\xe7\xc9\xf6\x89\x28\xf1\x65\x53\x58\xa6\xb6\xee\xb5\x36\x0d\x05\x1b\xc6\xb2\xb5\xf5\x35\x5b\x4b\xff\xd2\x96\x4c\x18\x15\x27\x48\x55\x83\xe3\x51\xe7\x18\xad\xac\x12\xfc\x78\x75\x1e\x51\xd7\x90\x88\x16\x4a\x14\x1b\xb3\x5a\xdd\x4a\x25\x3d\x87\x93\x80\x78\x52\x4d\x8d\x9e\x92\xc1\x3d\x2f\x86\x25\xe7\xd1\xfa\xa5\xa9\xa5\xd8\x72\xb8\xa3\x6e\x30\x2b\x8c\xf6\x28\xc3\x80\x6e\x4f\x9e\xef\xac\xa8\xfd\xe6\x76\x38\x80\x54\xb8\x4e\x1e\xaf\xe2\x0b\x58\x11\x7a\xb0\x61\xc3\x17\xa1\x37\x4b\x99\xc1\x3d\x44\xb6\x5c\x4d\x52\xcf\x8c\x52\xa8\xab\x31\x59\x24\x88\x8f\xab\x2d\xea\x5f\x7a\x4d\x08\xcc\xe1\x28\xee\x2a\x40\x47\x63\x0d\x3a\x64\xfd\x44\x48\x77\x7c\x24\x92\x36\xb0\xf8\x1f\xfe\xed\xf3\x65\x56\x3c\x0c\x29\x80\x0e\xeb\x36\x2d\xa1\x20\xdb\xc9\xdd\x1d\x4d\x5c\xd5\x94\xd6\x32\xcb\x27\xb4\x7a\xcb\xfd\x7c\x4b\x63\xfd\x81\xf7\xc3\xf2\x3c\xcb\x1e\x9e\x97\xa7\x45\xf1\x2f\xcb\xcf\xbf\xeb\xfc\xb5\x46\xf1\x11\x18\x4e\x80\x84\x25\x7f\x43\xdb\x70\xbc\x87\x99\xf1\xb9\xf5\x8d\x8b\x9e\x3a\x36\x9e\x62\x43\xe1\x5e\x1a\x74\xee\xcd\xd8\x6a\xf6\x09\x3a\x3b\xbd\xdb\x81\x03\x00\x00")
+
+func artifactsClusterJobMigrationYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterJobMigrationYaml,
+		"artifacts/cluster-job-migration.yaml",
+	)
+}
+
+func artifactsClusterJobMigrationYaml() (*asset, error) {
+	bytes, err := artifactsClusterJobMigrationYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-job-migration.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterProxyDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x54\x5d\x6f\x1a\x31\x10\x7c\xe7\x57\xac\x68\x5f\xef\x0e\x1a\xa9\xaa\xee\xa1\x52\x04\x89\x1a\x15\x0a\x85\x7e\xa8\x52\x25\xe4\x98\x05\xac\xd8\x67\xc7\xf6\x91\x20\x9a\xff\x5e\xfb\x0c\xc6\x97\x92\xc6\x4f\x78\x77\x76\x3c\xb3\xbb\x1c\x51\xec\x07\x6a\xc3\x64\x55\x02\x51\xca\x14\xdb\x7e\xe7\x8e\x55\xcb\x12\x86\xa8\xb8\xdc\x09\xac\x6c\x47\xa0\x25\x4b\x62\x49\xd9\x01\xa8\x88\xc0\x12\xf6\x7b\x98\x6a\xf9\xb8\xfb\xe2\x6e\x90\xcf\xd0\xc8\x5a\x53\x6c\x6e\x4f\x4f\x0e\xc5\xc9\x2d\x72\xe3\xf1\xe0\x69\x9f\x17\x9c\x80\xf2\xa1\x42\x3d\xc3\x15\x6a\xac\x28\x1e\x2a\x32\x08\x12\xc6\xbb\xf9\xd7\xd1\x80\xd7\xc6\xa2\x6e\x12\x9e\xec\xa4\x97\xea\x5c\xec\xcc\x3d\x97\x0a\x35\xb1\x52\xe7\x6b\x6d\x79\xbe\x66\x76\x53\xdf\xe6\x54\x0a\xef\x25\x54\x45\xd1\xc9\xcb\xfe\xd4\x6c\x19\xc2\xdf\x6f\x86\x3e\x6a\x14\x52\x2f\x41\x3b\xef\x8c\x12\x13\x92\x73\x17\xcd\x1b\xf5\xce\x69\x48\x04\x0a\x83\x1c\xa9\x7b\x38\xa8\x16\xc4\xd2\xcd\x28\x31\xfe\x8a\x75\x8b\x42\x71\x62\xf1\x50\x9d\xf4\xd8\x1f\xde\x22\x7a\x85\xca\x49\x39\x28\xf7\x87\xca\xca\x12\xe6\xfa\x1a\xcb\xb3\x43\x07\x94\x2f\x76\x1d\x8b\xac\x4c\x90\x75\xe8\xcc\x8d\xff\xd5\xf2\x1a\x22\xb1\x57\x00\x58\x6d\x4f\x7a\x8e\x94\xe3\x5f\x6e\x46\x8b\xd9\x64\xf2\x6d\x31\xbd\x9c\xcf\x7f\x4e\x66\xc3\x88\x01\xd8\x12\x5e\xe3\xb5\x96\xa2\x4c\x82\xbe\x71\x54\xa3\xfd\x8c\x3b\x37\xf9\x76\x26\x9d\x55\xa3\x65\xde\x40\x53\x19\xe1\xdc\xe1\xce\xf9\x21\xc6\x3c\x48\xbd\x8c\x39\x37\x75\x41\xdc\xea\x24\x32\xcd\x26\xb9\x74\x33\xda\x4d\xae\x7f\x3a\xa9\x26\x0b\x19\x26\x81\x37\x70\xcd\x38\x07\xbb\xc1\xf8\x0e\xb0\xca\xca\x26\xe2\x7a\xbc\x62\xeb\x38\x43\x58\x39\x8f\x4d\x22\x58\xcb\x5b\xc4\x4b\xe8\x9a\xe2\x4c\xa3\x16\xd3\xd1\xe5\xe0\xea\xd3\x64\x34\xbc\x9a\x15\x6f\xf7\x67\x10\x4f\xc5\xba\x0b\xbf\x5b\xd6\x0b\xb4\xb4\x38\x0e\x32\x0b\x3a\xe2\x3d\xa7\xd5\x2a\xb7\x8a\xc3\xc7\x36\xce\xc7\x13\x16\x7c\x44\x1a\x97\x01\xb2\x15\x64\xf4\x3f\x78\x25\xb5\x35\xff\x4e\xbe\xc1\x26\xa4\x71\xed\xa6\x0e\x5f\xc2\xfb\xde\xc5\x45\xcc\x6e\x25\xaf\x05\x8e\x65\x5d\xbd\xc8\x74\x72\x93\x70\x0a\x5f\x31\x25\x76\x53\x9e\xf5\x1d\x91\xfa\xf0\x05\x32\xe9\x36\x69\xbc\xaf\xd1\x58\xd3\xde\x30\xaa\xea\x12\xfa\xbd\x9e\x68\x45\x05\x0a\xa9\xdd\x46\xf5\xdf\x7d\x18\xb3\x4e\x2a\xfa\xc5\x3f\xd1\x73\x0d\xe1\x3a\x26\x2a\x7d\xaf\xfd\xb9\x1c\x1c\x21\xe7\xbf\x9b\x7f\x01\x1d\x22\x95\x38\x8d\x05\x00\x00")
+
+func artifactsClusterProxyDeploymentYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterProxyDeploymentYaml,
+		"artifacts/cluster-proxy-deployment.yaml",
+	)
+}
+
+func artifactsClusterProxyDeploymentYaml() (*asset, error) {
+	bytes, err := artifactsClusterProxyDeploymentYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-proxy-deployment.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterServiceMetricsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x51\x41\x6e\x02\x31\x0c\xbc\xf3\x0a\x0b\x71\x2c\xa1\x48\xbd\x74\xa5\x9e\xda\x0b\x12\x20\x0a\x6a\xef\x26\xeb\x2e\x11\xd9\x24\x75\xb2\x54\x08\xf1\xf7\x66\x93\xa8\x80\x44\x8f\x63\x7b\xec\x99\x31\x3a\xf5\x49\xec\x95\x35\x15\x1c\xa6\x83\xbd\x32\x75\x05\x1b\xe2\x83\x92\x34\x68\x29\x60\x8d\x01\xab\x01\x80\xc1\x96\x2a\x38\x9d\x60\x41\x81\x95\xf4\x65\x66\x19\xcb\x20\xd6\xe4\x6d\xc7\x05\x9d\xcf\x71\x5c\xe3\x96\xb4\xef\x89\x00\xe8\x5c\x62\x8a\x4b\x1b\x22\x1e\x03\xa3\x69\x08\x46\x7b\x3a\x3e\xc0\xe8\x80\xba\x23\xa8\x5e\x40\x6c\x1c\x49\x51\xce\x88\x79\x5a\x74\x61\xa5\xf1\x08\xd3\xc6\x42\xba\x5a\x49\xa6\xce\x10\x8d\xb1\x01\x43\x34\x56\x54\x38\xb6\xd1\xcf\x8e\x3a\x2f\x94\x9d\x78\xc9\xe8\xa2\xa1\x61\xe0\x8e\x86\x77\x06\x9c\xe5\x10\xdb\xcf\xd3\xc7\xa7\x7b\x6d\x65\x42\x0c\x00\x75\x36\x76\xa3\x78\x93\x56\xcf\xca\x40\x56\x63\x7f\x0c\xf1\x9a\xbe\x88\xc9\x48\x2a\x8a\xc6\x90\xe3\x5e\x1c\x37\xef\xf3\x57\xdd\xf9\xc8\x48\x8d\x3e\xb2\xcb\x5b\x24\x8b\xf6\xe8\xbf\xb5\x75\xc4\x18\x2c\x8b\x86\x83\x16\x8d\x0a\xbb\x6e\x2b\xa4\x6d\x27\xf1\x6f\x99\xf5\xf7\xa3\x9b\xa4\x01\x3a\x55\xe7\xf2\xc7\xec\xad\xaf\xfa\x28\xb7\x97\xd0\x7b\x4c\x5a\xc6\x85\xda\x66\x0b\xd9\x6f\x0a\xa0\xf7\x9f\x60\x40\x6e\x28\xac\xae\x8b\x32\x4b\x9e\xad\x2a\x58\x5a\x43\xb1\xe2\x49\x93\x8c\x12\xff\xf9\xfb\x2f\x8a\x64\x76\x9a\x6d\x02\x00\x00")
+
+func artifactsClusterServiceMetricsYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterServiceMetricsYaml,
+		"artifacts/cluster-service-metrics.yaml",
+	)
+}
+
+func artifactsClusterServiceMetricsYaml() (*asset, error) {
+	bytes, err := artifactsClusterServiceMetricsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-service-metrics.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterServiceProxyYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x90\xc1\x4e\xc3\x30\x10\x44\xef\xf9\x8a\xfd\x81\x1a\x50\x25\x0e\xb9\x96\x0b\x12\xa0\xb4\x11\xbd\xbb\xce\x10\x2c\x1c\xdb\x5d\x3b\x85\xa8\xea\xbf\x63\x3b\x2d\x2d\xbe\xed\xee\xcc\xce\x5b\x4b\xaf\xb7\xe0\xa0\x9d\xad\xe9\xf0\x50\x7d\x69\xdb\xd5\xd4\x82\x0f\x5a\xa1\x1a\x10\x65\x27\xa3\xac\x2b\x22\x2b\x07\xd4\x74\x3c\x52\xc3\xee\x67\x7a\x4b\x15\x89\x0d\x82\x1b\x59\xa1\x54\xa7\x53\x52\x19\xb9\x83\x09\x59\x4f\x24\xbd\x2f\x06\x71\x1d\xbb\x6f\x0b\xde\xe0\x03\x0c\xab\x70\xd6\x2d\x68\x4e\x7d\x9d\xda\xf5\xcb\xca\x8c\x21\x82\xcb\x20\xaf\xb8\xd2\x29\x16\xc3\x14\xf6\xc6\x79\xb0\x8c\x8e\x45\xcf\xd1\x88\x5e\xc7\xcf\x71\x27\x94\x1b\xee\x12\xfe\xec\xfa\x43\xbd\x49\xce\x6f\xd4\xdd\xdc\x7e\x7f\x7e\xca\xdd\xe0\xa1\x32\x82\x77\x1c\x0b\xcb\xe2\x6c\x2d\x39\xc5\x94\x47\xb3\xa7\x4d\x62\xd1\xa4\xf2\xb2\x2f\x4a\xee\x11\x9b\x22\x78\xbc\x5f\x2e\x53\x33\xc0\x40\x25\xb4\xff\xf7\xdf\x7c\xd8\x85\xe7\x17\xec\xfc\xae\x24\x76\x01\x00\x00")
+
+func artifactsClusterServiceProxyYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterServiceProxyYaml,
+		"artifacts/cluster-service-proxy.yaml",
+	)
+}
+
+func artifactsClusterServiceProxyYaml() (*asset, error) {
+	bytes, err := artifactsClusterServiceProxyYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-service-proxy.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterServiceReadYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x50\x3d\x4f\xc3\x30\x10\xdd\xf3\x2b\x6e\x84\xa1\xa6\x55\x25\x86\xac\xb0\x54\x82\x2a\x24\x82\xfd\xea\x5c\x83\x85\x63\x9b\xb3\x53\x14\x55\xfd\xef\xd8\x4e\x42\xbb\xe0\xed\xde\xc7\xbd\xe7\x43\xa7\x3e\x88\xbd\xb2\xa6\x84\xd3\xa6\xf8\x52\xa6\x2d\xa1\x21\x3e\x29\x49\x45\x4f\x01\x5b\x0c\x58\x16\x00\x06\x7b\x2a\xe1\x7c\x86\x9a\xb0\x9d\x05\xfb\x88\x81\xa8\xc9\xdb\x81\xe7\xe9\x72\x89\x5a\x8d\x07\xd2\x3e\xb9\x00\xd0\xb9\x6c\x13\x57\xda\xfe\x18\xe2\x9a\x8e\xc4\x64\x24\xcd\xba\x15\x4c\xd9\xaf\x63\xf3\xf6\xf2\xa4\x07\x1f\x88\x33\x91\x56\x5c\x3b\x4a\x16\xfd\xe8\xbf\xb5\x75\xc4\x18\x2c\x8b\x8e\x83\x16\x9d\x0a\x9f\xc3\x41\x48\xdb\x3f\xc4\x4f\x4c\xae\xbf\xc2\x37\xc9\xe9\x0d\xaa\x9d\xe0\xf7\xdd\x73\x42\xbd\x23\x99\x2a\x38\xcb\x21\x77\x59\xcd\xd6\x9c\x93\x4d\x89\x9a\x3c\x4d\x14\x8b\x2a\x8e\xcb\xbe\x80\xdc\x51\xa8\xb2\x60\xbb\x5d\x3f\x46\x50\x4e\xe5\x77\x55\x09\x7b\x6b\x28\x22\x9e\x34\xc9\x58\xf6\xdf\x8b\x40\x9a\xd5\x11\x8c\x0d\x70\x77\x73\xe0\x26\x1b\x7d\xc5\xaa\x47\x1e\x41\xdc\x2f\x72\xb6\x3a\x36\x64\x72\x5a\x49\x5c\x16\x90\x69\x13\xff\x0b\x10\x43\xca\x72\xd3\x01\x00\x00")
 
 func artifactsClusterServiceReadYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -258,7 +450,7 @@ func artifactsClusterServiceReadYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsClusterServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xc1\x4e\xc3\x30\x10\x44\xef\xfe\x8a\xfd\x81\x1a\xaa\x4a\x1c\x72\x85\x4b\x25\xa8\x0a\x11\xdc\x5d\x67\x08\x16\x8e\x6d\xd6\x9b\xa0\xaa\xea\xbf\x23\x3b\x29\xf4\x82\x0f\x3e\xec\xcc\xec\x3c\xad\x49\xee\x0d\x9c\x5d\x0c\x0d\x4d\x6b\xf5\xe9\x42\xd7\x50\x0b\x9e\x9c\x85\x1a\x20\xa6\x33\x62\x1a\x45\x14\xcc\x80\x86\x4e\xa7\x8b\xb8\x33\x03\x48\xd7\xff\x7c\x56\x44\xde\x1c\xe0\x73\x71\x12\x99\x94\xaa\xf5\x4a\x8e\xdf\x01\xfc\x82\x77\x30\x82\xc5\xe2\x5b\xd1\xdc\xf7\x74\x6c\x9f\x1f\xef\xfd\x98\x05\x5c\x85\xb2\xe2\x8f\xcb\xb2\x1e\x8e\xf9\xcb\xc7\x04\x36\x12\x59\xf7\x2c\x5e\xf7\x4e\x3e\xc6\x83\xb6\x71\xb8\x99\xd6\x4b\xea\x17\xf2\xaa\xb9\xbc\xd1\x75\xf3\xf8\x75\xfb\x50\xa6\x39\xc1\x16\x84\x14\x59\x2a\xcb\x6a\x89\xd6\x9e\x1a\x2a\xd2\x9c\x69\x13\xac\xde\x47\x96\xcb\x3e\x31\xdc\x43\xf6\xd5\xb0\xd9\xdc\xde\x29\x22\x3b\xc3\x6f\xf7\x0d\xed\x62\x80\x22\xca\xf0\xb0\x12\xf9\x9f\x8b\xfc\x04\x00\x00\xff\xff\x97\xdb\xcc\x3a\x78\x01\x00\x00")
+var _artifactsClusterServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x50\xcb\x4e\xc3\x30\x10\xbc\xe7\x2b\xf6\x07\x6a\x40\x95\x38\xf8\x0a\x97\x4a\x50\x85\x46\x70\x77\x9d\x21\x58\x38\xb6\x59\x3b\x45\x55\xd5\x7f\xc7\x76\x52\xca\x05\xdf\x76\x1e\x3b\xb3\x56\xc1\xbc\x81\xa3\xf1\x4e\xd2\xe1\xae\xf9\x34\xae\x97\xd4\x81\x0f\x46\xa3\x19\x91\x54\xaf\x92\x92\x0d\x91\x53\x23\x24\x9d\x4e\x17\x72\x9b\x67\x12\x3b\x44\x3f\xf1\x32\x9d\xcf\x59\x67\xd5\x1e\x36\x16\x07\x91\x0a\xa1\x5a\xc4\x95\xf6\xdf\x0e\xbc\xc3\x3b\x18\x4e\x63\xd1\xad\x68\xce\x7d\x3e\x76\x2f\x4f\x0f\x76\x8a\x09\x5c\x89\xb2\xe2\xda\x4f\xb3\x18\x8f\xf1\xcb\xfa\x00\x56\xc9\xb3\x18\x38\x59\x31\x98\xf4\x31\xed\x85\xf6\xe3\x4d\x3e\x60\x76\xfd\x96\xfd\x93\x5c\xde\x64\xfa\x19\x7e\xdd\x3c\x16\x34\x06\xe8\x52\x21\x78\x4e\xb5\xcb\x6a\xb1\xd6\x9c\x6a\x2a\xd4\xec\xe9\xb2\x58\xb4\x79\xbc\xec\x4b\x8a\x07\xa4\xb6\x0a\xd6\xeb\xdb\xfb\x0c\xea\xb9\xfc\xa6\x95\xb4\xf5\x0e\x19\x89\xb0\xd0\xb9\xec\x3f\x3f\xf2\x03\x21\x6e\x75\x1e\x80\x01\x00\x00")
 
 func artifactsClusterServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -278,7 +470,27 @@ func artifactsClusterServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _artifactsClusterStatefulsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x6d\x6f\xdb\x38\x12\xfe\x9e\x5f\x31\x70\x83\x8d\x73\x1b\xfa\xa5\x45\xaf\x38\xb7\xee\x21\xeb\xba\x6d\x70\x49\x9d\xb5\xbc\xed\x2d\x52\x9f\x97\xa6\xc6\x36\x11\x89\x54\x49\xca\x89\x2f\xcd\xfd\xf6\x03\x45\xd9\xa6\x64\x39\x4d\x17\xd8\x03\xf6\xfc\xc1\x90\x28\xce\xf0\x99\x87\x33\xc3\x19\x89\x26\xfc\x23\x2a\xcd\xa5\xe8\x00\x4d\x12\xdd\x5c\xb6\x0f\xae\xb9\x08\x3b\x10\x18\x6a\x70\x96\x46\x01\x9a\x83\x18\x0d\x0d\xa9\xa1\x9d\x03\x00\x41\x63\xec\xc0\xdd\x9d\x3f\xe1\x03\x8d\x11\x1a\xd9\xff\xfd\xfd\x01\x80\xbc\x11\xa8\x86\x38\x43\x85\x82\xa1\xb6\x62\x00\x04\x9c\xe2\x8b\x55\xf0\xf3\x79\x2f\x4a\xb5\x41\x95\x3d\x00\xf0\x51\x30\xd5\x88\x57\xfa\x4b\x24\x13\x54\xd4\x48\xd5\x98\x2b\x13\x35\xe6\xdc\x2c\xd2\x69\x83\xc9\xd8\x22\x74\x52\x1b\x24\xde\xca\xf6\x97\xf2\xd0\x0d\xff\x72\xf6\xc6\x8e\xea\x04\x99\x85\xa0\x31\x42\x66\xa4\x72\x70\x62\x6a\xd8\xe2\x9c\x4e\x31\xca\xf1\x59\x18\x49\x59\x9f\x46\xb5\xe4\x0c\x3f\x54\x2c\xa5\x30\x89\x38\xa3\xda\x8d\x07\x09\xb2\xc6\x30\x1f\x72\x13\x0c\xc6\x49\x44\x0d\xe6\xeb\x79\x1c\xda\x5f\x54\x58\xba\x72\x71\x80\x35\x74\xfb\xe3\x82\x9b\x9e\x14\x86\x72\x81\x6a\x23\x48\x72\x1a\xec\x53\x92\x11\xb7\xd1\xc8\x63\x3a\x47\x0f\xdd\x99\xbd\xdf\xd2\x04\xc0\x64\x1c\x53\x11\x6e\x31\x10\x98\x52\xbd\xf0\x6e\x6b\x84\xd5\xbc\xdb\xaf\x9b\x6b\xcb\x8c\x01\x82\xb7\xde\xc8\x13\x78\x87\xc2\x6e\x1a\x42\x06\x24\x23\x0f\x15\xe1\x21\xcc\x94\x8c\x21\x91\x21\x48\x15\x72\x41\x23\xe0\x22\xc4\xdb\x86\x27\x7c\x75\x05\xbf\x2d\xa4\x36\xd6\x9a\xdf\xa0\xfb\x1f\x20\xf5\xab\x16\xf9\xdb\xf8\xc7\xe3\x43\x18\x8f\xe1\xeb\x57\xc0\x5b\x6e\xa0\xed\x89\xe4\xba\xba\x87\x77\x3f\x9d\x06\xef\x27\xc3\xfe\xc5\xe9\xa8\xf7\xfe\xaa\x3d\xbe\xf7\x26\x21\x5b\x48\xb8\xca\xf0\x84\x63\x78\x0d\xcd\x58\x98\x26\x93\x62\xd6\x08\x9b\x1b\x7c\x0d\x26\x66\x05\x43\x4e\xc3\x10\xa8\x00\x39\x9b\x59\x33\x8d\x04\xba\x94\x3c\x04\x85\x99\x48\xb8\xb5\xac\xdb\x82\x25\x8d\x52\x6c\x94\x97\xdc\xce\x38\xac\xd7\xdb\xad\x16\xfc\x08\x87\x39\xe0\xe3\x63\x78\xfd\x58\x20\x3d\x99\xac\xac\x6b\x28\x99\x28\x6e\x99\x75\x12\x30\xe3\x11\x6a\x47\xab\x1d\xe1\x73\x12\xd3\xc4\x02\xc5\x38\x31\xab\x37\x5c\xf9\x80\xf8\xcc\xd2\xbb\x5e\x1e\x08\x7e\x81\x16\x8c\xc7\x2f\xc1\x2c\x50\x78\xf3\x00\x58\xb2\xc5\xe5\x74\x36\x63\x6a\x03\xd5\x02\x2b\x40\xf6\xed\x8d\x34\x7e\x4b\x8b\x8e\xe8\x12\x1f\x52\x32\xe3\x9b\x9b\xa5\x8c\xd2\x18\x2f\x64\x2a\x8c\xf6\x7d\xd3\x39\xba\x15\xf5\xe4\x62\x3b\xed\x92\x9a\x45\xc7\xd7\x5c\x29\xe5\xa0\x7c\x43\xb6\x30\x69\x23\x1d\x49\x81\xd5\xd1\x65\xb3\x53\xf3\xd6\x28\x3a\xa5\xec\x3a\x4d\x3a\x36\xe0\xb5\xf9\xe3\x42\x2c\xb8\xe6\x89\xdd\x37\x87\xc9\x6e\xad\x4d\x2a\x40\x23\x85\x34\x5c\xd9\x20\xd1\x46\x97\x02\x8b\x84\xd0\x5c\x52\xd5\x8c\xf8\xb4\x99\x19\xe1\xfe\x6d\x5c\xfd\xf0\x83\x8b\xab\xd6\xff\x20\x14\x9f\xc0\x10\xb5\x91\x0a\x1d\xe4\xcc\x79\x1d\x6b\x20\x05\xe4\x7e\xf6\xbb\xdc\xf6\xee\xce\x4e\x16\x98\x27\xba\xb7\x4a\xc6\x3f\x39\xc5\xb5\x9a\x9f\xf0\x7e\x0f\x0a\x80\xed\xee\x02\x21\x89\xc2\x84\x2a\x04\x42\x0c\x55\x73\x34\x24\xe4\xaa\x9b\xb3\xea\x26\x35\xf7\x0b\x33\x99\xac\x88\xbd\x7b\xb4\xf8\xdd\x1d\xa0\x08\x77\x6d\x28\xb9\xc1\x06\x39\xd4\x0b\x19\x16\x5a\xc7\x45\x5b\x76\x36\xdb\x8b\xbb\x2c\xdf\x64\xea\xb6\xcc\x24\x0a\x97\x5c\xa6\x1a\x12\x2c\xd2\x22\x18\x35\x40\x88\x42\xb6\x24\x52\x44\x2b\xff\xe8\x22\x87\xf5\xfa\x7a\xdb\x48\xfb\xf8\xb8\xe1\x3d\x83\x67\xcf\x5a\x2f\xe0\x2b\xdc\x4e\xb5\x51\x48\x63\x20\xb7\x40\x7a\x25\xff\x2c\x40\xba\xcc\x19\xb7\xd6\x3a\x8a\x7c\x1c\x8f\xd8\x9c\x6a\xd5\x0f\x67\x19\xcb\xc0\x9e\x4c\xb1\x0f\xa9\x4e\xa7\x6e\x46\xf1\xc1\x23\xf2\x16\x1a\x96\xbb\x40\x29\x7b\x3d\xd6\xaf\xd7\x6b\x28\xe7\xd9\xfb\x52\x9c\xe7\x65\x55\xb8\xed\x2e\x39\xf5\x67\x42\x1b\x2a\x18\x96\x0a\xab\x5d\x77\x64\x7b\xeb\x91\xef\x2c\x45\x50\x2c\x77\x37\xe1\xe2\xd7\xe0\xe7\xf3\xc9\x70\x30\x18\x4d\x2e\x4f\x83\xe0\xd3\x60\xf8\xc6\xc3\x9d\x9d\xbb\x96\x92\x4e\xc1\xc3\x35\x32\x85\xe6\x1f\xb8\x1a\xe2\xac\xf8\xc4\xaf\x18\x33\x14\x41\x36\xb5\x1c\x5c\x00\xd7\xb8\xea\x40\x42\xb5\xbe\x91\x6a\xbb\x19\x89\x54\x55\x9e\x52\x76\x83\x0d\x25\x97\x52\x99\x8e\x75\xf7\xbf\xfe\xa9\x7c\x4e\xa1\x96\xa9\xda\x54\xed\xeb\xc1\x2f\x29\x6a\xa3\x3b\xa5\x73\x3e\xed\xc0\xf3\x56\x2b\x2e\x8c\xc6\x18\x4b\xb5\xea\x40\xfb\xdd\x36\xb5\x44\x7c\x89\x02\xb5\xbe\x54\x72\x8a\xbe\x12\xbc\xdd\x16\xb8\x6b\xfe\xdc\x89\x09\x57\x35\x7b\x52\xd6\x4e\xb2\x13\xf2\x04\x6a\x19\x5a\x1a\xc6\x5c\x40\xc2\xc5\x1c\x48\xf2\xb9\x76\x78\x57\xe1\x23\xf7\x9f\x6b\xb5\xb1\x7f\x8e\x08\x6e\x38\x8d\xde\x60\x44\x57\x01\x32\x29\x42\xdd\x81\x67\x7e\x12\x4c\x50\x71\x19\x6e\x9e\xb5\xfd\x67\x86\xc7\x28\x53\xb3\x79\xf8\xdc\x63\x8a\x86\xfc\x91\x56\x3d\x81\xde\x02\xd9\x35\xdc\x20\x30\x2a\xb2\x09\xa9\x41\xf8\x92\xa2\xe2\xa8\x41\x2e\x51\xc1\xa8\x77\x09\x75\x7d\xcd\x13\x22\xd0\xdc\x48\x75\x6d\xad\xe4\xda\x56\xa1\xa5\x2c\xfe\x30\x47\x40\x16\xd0\x7e\xfa\xa2\xd1\x6a\xb4\x1a\xed\x07\x69\x02\x82\x70\x14\xf4\xcf\xfb\xbd\x11\xb4\x8f\xbe\x4d\xda\xf3\xfd\x9c\x3d\x7d\x80\xb2\x76\x29\x37\x6c\x73\xf6\x77\x56\x53\x7f\xde\x2c\x51\x61\x72\x55\xaa\x78\xf1\x47\x15\x8e\xac\x5c\x02\x1e\x14\xce\xd8\x37\x68\x50\xc5\x5c\x20\x4c\xb9\x88\xe4\x1c\x12\xa9\xb9\xe1\xd2\xb6\x40\xae\xc4\x08\xb3\xf4\x74\x62\xcf\x23\x2a\x56\xbb\x65\x1a\x99\x79\x36\x4e\xb2\x72\x7f\xc2\xc5\x4c\x56\x17\x6c\x4f\xe0\x9f\x46\xd1\xfc\x2c\x5b\xd7\xae\xf3\xbc\x7b\x0c\x81\x42\x42\x95\x75\x3f\xa8\xf5\xde\x9f\x7e\x78\xd7\x87\x8b\xd3\x60\xd4\x1f\xc2\x68\x50\xcb\x62\x66\x55\xd2\x36\x45\x46\x53\x8d\x70\x83\x47\xca\xd5\x44\x36\x76\xb2\x0a\x26\x8b\x36\xae\x8d\x1d\x70\x5d\x48\x31\x5b\x2d\xf7\xe0\x66\x0b\x2a\xe6\x38\x71\x65\xd5\xc4\xc8\x86\xfe\x12\x35\x78\xd9\x8c\xb3\xb9\xb0\xa5\xa4\xa7\xc2\x11\xe8\x74\x70\x01\x66\xc1\x35\x30\xaa\x11\xea\xdc\x1c\x69\x48\x35\x46\xa8\x75\x29\xa0\x55\x5c\x22\xd0\xd3\x52\xe8\xb4\xaa\xc8\xf6\x57\xdc\xc3\xf6\xa7\x02\x2f\x21\x57\xc8\x4c\xb4\x72\x04\xe5\x25\x2f\x5c\x52\xa5\x77\xf6\xbf\x08\xd3\x76\x06\xb6\xee\xab\x5e\x3c\xeb\x15\xfe\x55\x6f\xfc\xe5\xef\xc7\x57\x57\x1d\x9d\x50\x86\x9d\xf1\xf8\xc7\x6c\x60\x6f\xe7\x90\xd9\xfe\x4d\xc3\xf3\xa6\x7a\xc7\x1b\xf2\xab\xc9\xf9\xe0\xdd\xe4\xed\xd9\x79\xbf\x7b\xb4\xdb\x81\x1c\x9d\x7c\x2e\x45\xae\xfd\x79\x82\x97\x83\xa0\xdc\xb8\x3c\x1d\xdf\xd7\xe0\xf5\x23\x7c\x60\xc6\x8b\x81\xe4\xb2\x3c\x9f\xd9\x44\x2f\x10\x43\xdb\x92\x33\x19\x27\x11\x1a\x04\x9a\x57\xeb\xd3\x15\x68\x63\x1d\x5c\xcc\xd7\x6f\x91\xca\x5c\x6f\xf6\x79\x0f\x84\xea\x8d\x76\x24\x7d\xa2\x3c\x53\x3d\x93\xca\xd5\x05\x19\x8a\x29\x82\x0b\xb3\x3a\x65\x0c\x93\x6c\x06\x93\x42\x20\xb3\x6b\xeb\xe3\x5a\x41\x53\x2a\x0c\x8f\xa0\xf2\x38\xd9\x73\x9a\x64\x87\x49\x6d\x7d\x98\xd4\x5e\x42\x28\x41\x47\x88\x09\xb4\xed\xb5\xc0\x83\x0a\xa8\x67\xee\x94\xe1\xff\x2e\x51\x91\xbf\xd9\xc8\xd8\x5a\xbb\x62\xad\x1c\x7a\xc2\xc5\x95\xcd\x50\xeb\x44\x9a\x55\xc0\x54\x99\x13\xa0\xc6\x60\x9c\x18\x17\x7f\xd4\x90\x58\x6a\x43\xa4\xad\x69\xcb\xe1\xbf\x8f\xe1\xca\x71\xa9\xf8\xbc\xa8\xe0\xbb\x28\x7a\xf5\xaa\x3f\x78\xeb\xc9\x1f\xd6\x5f\xed\x5d\xe6\xf8\xe4\xa0\xc2\x61\xdf\x0f\x82\x51\xf7\xc8\x6f\xb6\x5a\x7e\x7b\x75\x54\x29\xf4\x4b\xd0\x1f\x76\x8f\x94\x94\xa6\xfa\xf9\x1a\xa1\x0d\x9f\x2a\xe0\xd5\x52\xbd\xc1\x87\x0f\xfd\xde\x68\x32\xec\x8f\x86\xbf\x76\xdb\xad\x97\xde\xa4\x60\x74\x3a\x1c\x41\x70\x7e\xfa\xb1\xef\x0f\x17\xad\x2f\x07\x4f\x60\xb7\x0e\x68\xfe\xee\xcc\x3a\xad\xb6\xdd\x86\xcb\x0c\x1a\x6e\x16\x28\xd6\x95\x28\x86\x36\x8a\x6c\x53\x5a\x78\xd9\x61\xeb\xaa\x75\x6b\x1a\x71\x6d\x50\x00\x21\xd7\x88\x09\x91\x49\x76\x6d\x15\xba\x7e\x95\x90\x98\xde\x12\x1b\x01\xba\xdb\x76\x5d\x29\x61\x50\x4c\x15\xb5\x42\x77\xb9\xb9\xc8\x4e\x09\x92\xe5\x5b\x42\x5c\x1b\xdb\xdd\xf6\xb3\x64\x21\xb5\xe9\x7a\xee\x40\x52\x8d\xaa\x6b\xd9\xb7\x2d\x6a\x5e\x30\x74\x1f\x28\x5e\xff\xaf\xfb\x85\xf6\xde\x7e\xa1\xd5\xba\x58\x77\x0c\xce\xf0\x9d\xa6\xb2\x80\x6f\xfd\xa6\xb3\x03\x77\xf7\x15\xf3\x4a\x6f\xfb\xdc\xd0\x05\x4d\x7c\x48\xbb\x1d\xdc\xe3\x3a\xee\x7d\xfd\x76\x82\x4a\x67\x5e\x67\x3e\x66\x06\xf4\x22\xca\x0b\x85\x27\xb3\x03\x9b\x8f\x09\x97\x1f\x7b\xee\xcb\x49\xa9\xeb\x76\x65\x27\x5b\x60\x98\x46\x5e\x97\xec\x37\xdf\xcb\xad\xfe\x51\xfe\x99\x21\x63\x8b\xec\x7c\x67\xd8\xf1\x95\xef\xfe\xf0\x60\xcf\x0b\xad\x2f\x64\x88\xda\x76\x1c\x43\xa4\xe1\x27\xc5\x0d\x0e\x04\xc3\x4d\xcf\x50\xe1\x06\x55\x4e\x60\xf9\x2a\xbe\x0a\x08\xdc\x88\x5d\xf8\xbf\x01\x00\x00\xff\xff\x37\x96\xfe\xda\x8d\x1a\x00\x00")
+var _artifactsClusterServicemonitorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x51\xc1\x4e\xc3\x30\x0c\xbd\xef\x2b\x7c\xd8\x91\x06\x71\xad\xc4\x09\x2e\x93\xda\x49\xac\x82\xbb\x97\x9a\x2e\x5a\x9a\x04\x27\x2d\xaa\xa6\xfd\x3b\x69\x52\xe8\x26\x71\x8a\x9e\xfd\xde\x8b\xfd\x8c\x4e\x7d\x10\x7b\x65\x4d\x09\xbd\x35\x2a\x58\x56\xa6\x13\xd2\x32\x59\x1f\x9f\xfe\x71\x7c\xda\x9c\x95\x69\x4b\x68\x88\x47\x25\xa9\xce\xac\x4d\x4f\x01\x5b\x0c\x58\x6e\x00\x0c\xf6\x54\xc2\xe5\x02\x35\x05\x56\xd2\x2f\xd4\x7d\x2c\x83\x38\x90\xb7\x03\x2f\xe8\x7a\x8d\x74\x8d\x47\xd2\x7e\x16\x02\xa0\x73\x49\x29\xd6\x36\x44\x5c\x00\xa3\xe9\x08\xb6\x67\x9a\x1e\x60\x3b\xa2\x1e\x08\xca\x67\x10\x8d\x23\x29\x96\x6f\x44\x95\x8c\x56\x55\xa2\x47\x98\x1c\x17\xd1\x8d\x25\x99\x36\x43\xfb\x6d\x88\x0f\xf4\x49\x4c\x46\xd2\x32\x49\x01\x79\xcf\x7a\x6a\xde\xaa\x17\x3d\xf8\x40\x9c\x1a\xf3\x90\x6b\x4a\x92\x45\x3f\xf9\x2f\x6d\x1d\x31\xc6\x20\x44\xc7\x41\x8b\x4e\x85\xd3\x70\xfc\x0d\x2c\xab\xfe\x52\xb9\xdb\x0d\x60\x50\x6d\x2e\xbf\xef\x5e\xe7\xaa\x8f\x2b\xcd\x23\x78\xd2\x24\xa3\x63\x1e\xa7\xc7\x20\x4f\xd5\x4d\x52\xff\x66\x15\x57\x72\x56\x99\x90\x38\x05\x38\xcb\x21\x1e\x32\xc7\x93\x54\xb1\x17\x8f\x81\x3a\x0b\xef\xd2\x6b\x24\xa3\xa3\xdd\x42\x98\xfd\x7e\x00\x87\xb1\xfa\x0f\x0e\x02\x00\x00")
+
+func artifactsClusterServicemonitorYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterServicemonitorYaml,
+		"artifacts/cluster-servicemonitor.yaml",
+	)
+}
+
+func artifactsClusterServicemonitorYaml() (*asset, error) {
+	bytes, err := artifactsClusterServicemonitorYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-servicemonitor.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterStatefulsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x1b\x6b\x73\xdb\x36\xf2\x7b\x7e\x05\x46\xf1\xd5\x52\x62\xea\x91\x5e\xdb\xa9\x12\xf5\xce\x95\x95\xc4\x53\xdb\x72\x2d\x35\xb9\xd4\xf5\xe9\x20\x12\xb2\x38\xa6\x08\x06\x00\x65\xab\x49\xee\xb7\xdf\x2e\x40\x4a\xe0\x4b\x96\xd3\xf6\x66\xe2\xce\x34\x12\xb0\x58\xec\x2e\xf6\x0d\x88\x46\xfe\x1b\x26\xa4\xcf\xc3\x2e\xa1\x51\x24\x5b\xcb\xce\xa3\x1b\x3f\xf4\xba\x64\xa4\xa8\x62\xb3\x38\x18\x31\xf5\x68\xc1\x14\xf5\xa8\xa2\xdd\x47\x84\x84\x74\xc1\xba\xe4\xc3\x07\x1b\xe0\x0c\xc6\x48\xf3\x82\x49\x1e\x0b\x97\xe9\x6f\x9f\x3e\x01\x2c\xbf\x0d\x99\xb8\x60\x33\x26\x58\xe8\x32\x89\xcb\x09\x71\x88\xd9\xe0\x74\x35\xfa\xf9\xa4\x1f\xc4\x52\x31\xa1\x27\x08\x50\xb0\xa1\xc6\x15\xcd\xc5\x4a\xbe\x0f\x78\xc4\x04\x55\x5c\x34\xaf\x85\x0a\x9a\xd7\xbe\x9a\xc7\xd3\xa6\xcb\x17\x48\xa9\x59\xb5\xa6\xa8\xb9\xd9\x19\xff\x62\xdf\x33\xc3\xbf\x1c\x1f\xe1\xa8\x8c\x98\x8b\x24\x48\x16\x30\x17\x30\x1a\x72\x16\x54\xb9\xf3\x13\x3a\x65\x41\x42\x1f\x92\x11\xe5\xf1\x49\x26\x96\xbe\x61\xcd\x4c\x15\x99\x15\x2c\x0a\x7c\x97\x4a\x33\x3f\x82\xcd\x00\xc8\x0c\x19\x00\x18\xf6\x67\xc9\xcc\x2f\x11\xc8\x93\x8d\x14\xb0\xc6\xae\x57\x66\x3e\xce\x8c\x19\x62\xd4\x2a\x62\x16\xc2\xb2\x65\x30\xc9\x42\xaf\xb8\xc5\xf9\x9b\xfe\x05\x53\x2c\x54\x20\xce\x73\x0e\x74\x24\xf0\x11\x4a\x58\xe2\xc4\x1b\x1e\xc4\x0b\xd6\x0f\xa8\xbf\xc8\x41\x9a\xcd\x6f\xe7\x2c\x3c\x02\x61\x29\xe6\x59\x34\x14\xf1\x36\xdf\x6e\x00\x53\xf1\xe3\xda\x91\x4b\x83\x5d\x96\x1a\xb8\x22\x37\x8a\x2d\xa2\x00\x38\x4d\x0e\xca\x52\x42\xfc\x0b\x32\x67\x56\x7a\x6a\x70\x6e\xc9\x99\xe3\x9f\x2d\x9c\x91\x3b\x67\x5e\x1c\x30\x91\x55\x19\x69\x0f\x5b\x84\x57\x80\xdb\xc4\x5a\x3b\xbc\xe6\x52\x9d\x31\x75\xcb\xc5\xcd\x20\xa4\xd3\x80\x59\x20\xf3\xcd\x5c\x97\x28\x11\xb3\x64\xdc\x0b\x65\x22\x7a\x92\xd8\xc4\x4b\x5f\x48\xf5\x16\xf4\x3d\x41\xb7\x7d\x4f\x4d\xe6\xcb\xd1\x2b\xc1\xe3\xa8\x3f\xa7\xe1\x35\xb3\xcf\x5c\xf3\xc6\xdc\x58\xf8\x6a\xd5\xe7\xa1\x62\x77\x6a\x23\xb8\x99\x2c\xac\xb2\x58\xdf\x8a\x73\x1b\x31\x47\x67\x23\xd8\x6a\xe6\x5f\xe7\xa7\xb9\xc8\x43\x34\x87\x11\xaa\x84\x2c\x8c\x8f\x18\x15\x20\x7a\xb9\x41\x01\x72\x32\x73\x1b\xfa\x4b\x37\x2d\x59\x8a\x22\x30\x63\x99\xb5\x02\x19\xdb\x69\xb9\xa3\xa5\x62\x8f\xe4\xf9\xaf\x18\x29\x21\x2f\xe5\xd8\x02\xe4\x66\x68\x07\xe2\x4a\x16\x3b\x95\x6e\xd0\xa2\xe1\x0d\x0d\xe2\xdc\xcc\x12\x87\xba\xa4\x86\xeb\xd2\xe9\x5a\x76\xe5\x2e\x1c\xe6\xb5\x60\xeb\x77\x3f\xf4\x15\x6a\x21\xf5\x21\x3c\xac\xf9\x4d\x39\xc0\x59\x47\xbb\xfe\xf5\x06\xfe\x82\x5e\x1b\xde\x8e\xf1\x53\x22\x0f\xf3\xd9\xa2\x03\xa2\xc2\x82\x42\x68\xb1\x84\x32\xa5\x72\x6e\x7d\xad\x39\x6e\xcd\xfa\xfa\xd1\x62\x54\x32\x45\x1c\x76\x67\x8d\x3c\x26\xaf\x58\x88\xc1\x87\x11\x4d\x8e\x0e\x02\x4c\x38\xbe\x47\x66\x82\x2f\x48\xc4\x3d\xd0\x65\xcf\x0f\x69\x00\x44\x7b\xec\xae\x69\x2d\xbe\xbc\x24\xff\x41\x5b\x47\x9e\xfe\x43\x7a\xff\x25\x4e\xfd\xb2\xed\x7c\x7f\xf5\xb4\xb1\x47\xae\xae\xc8\xc7\x8f\x84\xdd\xf9\x8a\x74\xac\x25\x09\xae\xde\xde\x87\x1f\x0f\x47\xaf\x27\x17\x83\xd3\xc3\x71\xff\xf5\x65\xe7\xca\x3e\x2f\xe6\xce\x39\xb9\xd4\xf4\x78\x57\xe4\x07\xd2\x5a\x84\xaa\xe5\x82\x5a\x34\xbd\xd6\x9a\xbe\xa6\x1b\xce\x32\x8c\x1c\x7a\x1e\xa1\x21\xe1\xb3\x19\xb2\xa9\x38\xa1\x4b\x0e\x6c\x08\xa6\x97\x78\x1b\xce\x7a\x6d\xa3\x11\xcd\xfc\x96\x1b\x88\xbd\x7a\xbd\xd3\x6e\x93\xa7\x64\x2f\x21\xb8\xd1\x20\x3f\xec\x4a\x48\x9f\x47\x2b\xf4\xd4\x82\x47\xc2\x47\xc9\x9a\x15\x64\xe6\x07\x60\x6b\x5a\xac\xae\xd6\x71\x67\x41\x23\x24\x14\x42\x80\x5a\x1d\xf9\xc2\x26\x08\x54\x19\xc4\x9b\x6e\x0f\xa7\xf6\x9e\xb4\x41\xa8\xcf\x89\x82\x60\x62\xc1\x81\x46\x44\x1b\xba\x0c\xce\xd6\x82\xa2\x73\x45\xc2\x32\x24\xdb\xfc\x06\x92\xdd\x87\x45\x06\x74\xc9\xb6\x21\x99\xf9\x59\x23\x32\x86\xfc\x12\x96\x71\x91\xb8\xc5\xc4\xbf\xea\xa1\xac\x51\x6a\x89\x27\xbe\x66\x57\xd9\x16\xcd\xb2\xe0\x6f\xcd\xbe\xa7\x34\x32\xf9\x9a\x35\x96\x5d\x06\xc7\x04\x11\x08\x8e\xc2\xec\x95\x9c\x48\x0c\x96\x00\x5e\xc7\x1c\x12\x48\x9a\xb8\x26\x4e\xed\xcb\x04\x00\x52\xa9\xc8\x3e\xa6\xb5\xd8\x34\x32\xc7\x00\xb5\x9e\x14\xa4\x86\xc6\x60\x45\xc2\xed\xcc\x18\xaa\xc7\xfe\x82\xfd\xca\xc3\x52\x2f\x97\xce\x1d\xcb\xa1\x51\xf7\x6d\x4b\xb4\xa4\x3d\x36\xa3\x71\xa0\x1c\x05\x20\xce\xef\x00\xd3\x5b\x47\x3f\x6b\xd5\x43\x0e\x02\x34\x28\x2f\x52\x94\xb9\x47\x10\xbb\x24\x21\x83\x8f\x28\x42\xdc\x51\x8f\x11\x85\x79\x82\x24\x01\xa7\x1e\xf3\x0e\x88\xe4\x64\xc6\x83\x80\xdf\x22\x58\x06\x8f\x9b\x3a\x4e\xb3\xae\x8e\x1c\x2e\x7d\xaa\xd1\x8d\x7f\x05\xeb\x05\xbb\x02\x54\x0d\x70\x49\x70\x3a\xd4\x6b\xde\xcf\xed\xe8\xdd\x68\x3c\x38\xfd\x83\x8a\xb6\xf5\xbc\x46\xc0\xc9\xcf\x31\x13\xab\x13\x5e\xd0\x35\x93\x22\x69\xfa\x25\x32\xfc\x1e\xe1\x40\x10\xd7\x20\x05\x25\x98\x16\x1b\x38\x02\xa9\x3c\x1e\x2b\x32\x5d\x19\x48\xdf\x63\x2e\x15\x45\x37\x05\x18\x26\x1a\xc3\x04\x30\xf4\x3a\xbb\x32\x55\xb2\x78\x82\x3e\xa9\xd7\x02\x81\xb6\x02\x7f\xda\xd2\x2e\xb7\x85\x20\x4d\x98\x7c\x10\xde\x80\x87\xd7\x09\x5e\x14\xfa\x46\xbb\x6c\xb1\x34\xc7\x73\x70\xc7\x73\x1e\x78\x23\x06\x48\x3d\xf9\x87\x6c\x7f\xa9\x53\xfb\x53\x1e\x87\x4a\x76\x0b\x49\x02\xa2\xb3\x96\x2f\x10\xec\x9c\xaa\x79\xd7\xde\xad\x74\x95\x71\x7f\xf7\xac\xcd\x01\x7d\x96\x17\x5a\x6f\x6a\x3b\x8f\x6d\xfb\x96\xc2\x6d\xcb\x4f\x93\xe2\x0c\xca\x58\xa1\xe2\x08\x0d\x1d\xf4\x6b\x23\xfb\x5c\x56\x72\x4b\x21\x2b\x81\x98\xb5\xa0\x62\xf5\xd0\xbc\x84\x85\xcb\xe2\x19\x9c\xbe\x83\xf2\x77\x72\x31\x1c\x8e\x27\xe7\x87\xa3\xd1\xdb\xe1\xc5\x51\x3e\x2d\x7b\x09\x9e\xb6\x9b\x89\x44\x90\xc3\x0b\xa6\x7e\x62\x2b\x28\xaa\xb3\x33\x76\x19\x6c\x74\x4b\x83\x66\x8d\x0d\xff\x6e\x18\x64\xf7\x11\x95\x12\x4a\x10\xef\xaf\x4a\x9d\xfe\xaa\xec\xe7\x31\x19\x83\xf1\x27\xc7\x40\xa6\x9c\x2b\x70\x11\x34\x92\x04\xe2\x92\xaf\x24\x36\x1c\x50\xc0\x1c\x88\x17\xc2\x07\x1f\x09\xe3\x69\x9a\xd0\xce\xa5\x67\x85\xfc\x81\x7c\xf5\x95\x21\xab\x6d\x01\x26\x7b\xf5\x4a\x6a\x7e\xa7\xdd\x2c\xef\x04\x6c\xa8\x7d\x0d\xe6\x0c\xc2\x16\x34\x08\x58\xe0\x48\x54\x35\xe6\xad\x3b\x05\x04\x54\xd8\x0f\xb4\x3f\x4b\x59\x02\x17\xc0\x83\x25\x04\x02\x38\x8c\x0c\x26\x1a\xca\x5b\x48\x96\xb5\x77\xf4\x99\xd4\x11\x02\x16\xae\x88\xc7\x81\x5f\x05\xf9\x85\x6b\x7c\xe8\x5a\x28\x36\xbb\x33\xb0\xbe\x7a\xdd\x27\x3d\xd2\x7e\x4e\x7c\xf2\xa2\xd0\xa2\xa8\xb2\x02\x80\x7e\xfa\xb4\xd1\x78\x0e\xdb\x64\xf4\x08\xac\xe8\x5a\x57\xf2\xba\xa0\x95\xa4\xb6\x97\x70\x50\xc3\x9c\xd4\x63\xcb\x56\x18\x07\x01\xf9\x2d\xa7\x7c\x04\x65\x6c\x92\x69\x67\x6e\x2f\x72\x62\x01\x74\x13\x27\xaa\xed\x7d\x28\xb1\x8c\x4f\x00\xc1\x48\x6d\x34\x38\x19\xf4\xc7\xa4\x93\xdd\xe4\xd9\x0f\x5f\x75\x4a\x72\x3f\x52\x3c\xcc\x5c\x5a\x06\x9a\x1b\x30\x16\x65\xd4\xd0\x83\x80\x98\xf7\xdf\x35\xf4\xda\x90\xeb\x43\xf8\x41\x37\xe0\x87\xd7\x5a\xa0\xf6\xb9\x41\x80\x9a\x82\xd0\x40\x03\x20\x62\x79\x2b\xbb\x82\xca\x68\x7a\xde\x21\xad\xdd\x1c\xc4\x08\x76\x4f\xd5\x53\xc3\x0e\x58\xeb\x0e\xce\x76\x4a\xdd\x9b\x38\xea\x62\x6f\x44\xaa\x5a\xb1\xd8\x0c\x53\x57\x84\x1e\xe4\x47\x0d\x4c\x6a\x08\x97\xf8\x3f\x33\x74\x0c\x09\x02\x0d\x5d\xd6\xc4\x4e\x5e\x2c\x9b\x83\xd0\x15\x2b\x5d\x59\x82\x83\x29\xba\x8f\x52\x2f\x36\x1a\xf4\x2f\x06\xe3\xc9\xe0\xac\x7f\xf1\xee\x7c\x7c\x3c\x3c\x9b\xfc\x34\x78\xf7\x67\xf9\xb1\xcf\x22\xd3\xf2\x72\xf0\xbf\x62\x24\x28\xc6\xca\x2f\xc6\xf7\x81\xa7\x51\x5c\x30\x82\x5d\x30\x93\x86\x1b\x45\x40\x2f\x97\x94\x35\x9f\x55\x25\xdd\xa3\x34\x79\xc3\x07\xac\x8e\x47\x72\x89\x91\x31\xea\x52\xf4\xe9\x06\x40\x40\xea\x73\x34\x1f\x43\xc8\x65\x6e\x85\xaf\xd2\xae\x52\x8d\xa7\x03\xb5\xe2\x81\x3e\x26\xeb\xd9\x2e\xf1\x7c\x09\xc9\x9f\x97\x58\x20\x03\xf9\xfb\x52\x9b\xa5\x96\xcc\x94\xcd\x50\x4a\x38\x29\xcc\x4e\xcd\x1c\x32\xb1\x20\x8e\x98\xe5\x59\x78\x52\xa4\x5a\x27\xf2\xbb\x90\x2e\x6f\xfc\xe8\x78\x36\x40\x3a\x64\x29\xf5\x36\x00\x6a\x26\xb8\x9d\x52\xea\x0f\x34\xe4\x36\xe2\x4b\xbc\x5a\x45\xd1\x61\x76\x9e\x51\x3f\xe8\x02\xb2\x59\x0c\xf3\xe0\xa7\x12\xb4\x5a\x9e\xc5\xfd\x0b\xbb\x69\x07\xa8\xe5\x6a\x72\x4a\xe2\x4b\x1d\x70\x74\x61\x7e\x60\xf0\xe2\x62\x0b\x73\x5d\x94\x4a\xa9\x87\x94\x34\x6a\x65\xec\x74\x4a\xd8\xc9\xd7\x13\x05\xe7\xfd\x50\x83\x20\x64\xe3\x3b\x89\x03\xc9\x1c\x83\xc8\xcc\xe0\x13\xc4\x3e\x08\x66\x8e\xe7\x8b\x5e\xa2\x0a\x06\xa8\x55\x62\x25\x19\x35\x38\x82\x7d\xc1\x55\x64\xbb\x84\x49\x9e\xa2\x71\xea\x60\xaf\x41\x41\x69\x05\x8f\x08\x5b\x62\x71\xe3\x25\xeb\x40\x91\x85\xbe\x8a\x48\x8a\x1a\x40\x0d\x01\x88\xc9\x1c\x2e\x14\x37\x95\x37\x18\x74\x9a\x64\xb4\x02\xbe\x16\xba\x53\x0d\x2c\x12\xe4\x80\x06\xb7\x74\x25\x41\xa9\x22\x95\xe5\x17\xa3\x14\x6c\x01\xa5\x20\xc9\x32\xf6\xa4\x55\x88\xe8\x10\xfa\xa6\xbd\xbd\x3a\x92\x85\x9e\x0b\x82\x33\xac\xac\x35\x72\x30\x2e\x52\x0d\x53\xd3\x1a\x20\x2d\x04\x77\xbd\xc7\xc7\x88\x09\xd8\x78\x81\x3e\x7b\x62\xc8\xfc\x28\x57\x32\xd7\xcc\x2c\x91\xe0\xc7\xa4\xe3\xb1\x3e\xfc\x06\x79\xfe\xbc\xb0\xc7\x93\x46\x6a\xbe\x86\xc2\x22\x0c\x93\xd4\xcd\x0c\xe5\x62\x7a\x95\x7a\x65\xb4\xc3\xe5\xd1\xca\xc1\x6f\x0f\xd5\x8f\xcf\x0e\x5a\xa8\xce\x30\x8e\x4d\x31\xab\xbb\x92\x84\x4a\x72\xeb\xab\xb9\x1e\x87\xa0\x06\x3a\xe5\x82\x5b\x07\xe5\xa2\x2a\xd1\xf8\x1c\x26\x4c\x58\x74\x8a\x48\x89\xe0\x78\x4f\xe7\xe9\x75\xa8\x5b\x5a\x9b\xb8\x88\xe6\x34\x04\x7c\x60\xcc\x06\x41\xb3\xcc\xcb\xcf\x72\x6a\x63\x68\x69\xae\xe8\x22\x68\xb2\xd0\xad\x72\xf7\x3c\x62\xa1\x94\x01\x41\x10\x88\x14\x0e\x65\xd2\x79\xf6\xcd\xb7\x8e\x3b\x85\xef\xd1\xf4\xc6\x9b\x3d\x83\x7f\xa1\x04\xd1\x39\x45\x69\x0a\x51\x92\x39\xbe\xb8\x87\x98\x8f\xe4\x26\x86\x1c\x4c\x05\xd8\x5f\x0c\x56\x48\xbd\xb3\xcd\x7d\x94\x2b\x41\x89\x77\x2d\x07\x7c\x4c\x46\xa9\xa3\xd6\xc9\xdb\xc6\xe7\x90\x7a\xa6\x2b\x4c\xda\x8d\xe6\xf6\x2d\x32\x84\x15\xf0\xc2\x59\x68\xff\x46\x03\x9d\x5a\x12\xed\xae\x65\xae\x98\xa9\x0e\xc8\xa5\x55\xcd\x63\xd2\xd7\xb8\x37\x8e\x13\xbc\xe1\xd2\xe7\xb1\x24\x11\xcb\x7a\xcd\xd0\x05\x25\x73\x1c\x50\xb9\xa5\xc3\x43\x90\x6b\x59\x25\xb4\x57\xaf\xa7\x89\x86\xd3\x69\x34\xca\xea\x22\xf2\xf5\xd7\xed\xef\xe0\x90\xee\xa6\xa6\xab\x43\x9c\x3b\xe2\xf4\x73\x54\x67\x48\x3c\x4f\x1c\xb4\xae\x6a\x0a\x4a\xba\x83\x2f\x2f\x47\xbd\xbd\x35\x82\x12\xa9\x68\x33\x54\x51\x2a\xe3\xa9\x81\xc8\x4e\xec\xd0\x6c\x61\xca\x4d\x4e\x2b\xd7\x72\xd9\x35\x23\x4b\xf7\x48\x82\x4c\x55\x7f\xc4\x32\x9b\x32\xba\x4b\x72\xed\x7c\x31\x9b\xb7\x01\xb7\xf2\xfa\xe6\xb3\x6e\x6e\xbe\x80\x0e\xc9\x7d\xed\xe7\x94\xea\xf1\xaf\xc5\xdb\xb5\xb2\x76\xf2\x96\xfb\xb4\x88\x8b\x32\xd5\xcc\xeb\xdd\xfa\x0c\xce\x01\xbe\x8b\xf6\xf5\xed\x17\xa8\xe4\x89\x46\x84\xbe\x1a\xb9\xc2\x8f\x54\x99\x4c\xf5\x95\xa0\xd4\xd3\x15\x9b\x78\xdc\xbd\x61\xc2\x61\xa1\x82\x30\xcb\xfd\x50\x39\xb8\xc6\x9b\x66\xb7\xcb\xc9\x59\x24\x1e\x4a\xda\x8a\x22\xd8\xfb\x98\x49\x25\xbb\xb9\x2b\xa0\xb8\x4b\xbe\x69\xb7\x17\x99\xd1\x05\x5b\x40\xee\xd6\x25\x9d\x57\x1b\x0f\x1e\xf8\x4b\x16\x32\x29\xcf\x05\x9f\xb2\x6e\xa6\x0f\xb0\x79\x8a\x90\x2d\x3c\xc9\x65\x0d\x0b\xce\xda\x81\x2e\x34\x0f\x90\xd2\x48\x00\x0f\x90\xe2\xfc\xed\x7d\x8d\xd4\x4f\x6c\x94\x7d\xb3\x28\x73\x7b\xd4\x00\xae\xae\xec\xd2\x0f\x78\xf7\x69\x70\xc4\x02\xba\x4a\x7a\x39\xa0\x1f\x99\xb6\x16\x13\x3e\xf7\xd6\x73\x1d\x7b\x4e\x65\x9a\x40\xc0\xb6\x25\x30\x0a\x3e\x7e\x37\xe6\x20\xc4\xcc\x19\xa4\x4f\xb7\x10\xc7\x20\xd5\x40\x80\x18\x52\x9b\xa4\x7f\x65\xaa\x8f\x71\xff\x9c\xd4\xb1\xde\x71\x42\xf3\x24\x02\x0b\x09\x48\x49\xf8\x6c\x96\x8b\x99\xbb\x8a\xea\x22\x43\xe1\x67\xcb\xea\x9b\x6a\x51\x3d\xdb\x22\xa9\xce\xa3\x5d\xaf\x3e\x52\xcd\xc6\xfb\x04\x27\xe0\xd7\xd5\x4d\x9f\x69\x2c\x57\x53\x7e\x57\xd6\xec\xb1\x84\x62\x89\xa4\xa6\x78\xec\xce\x49\xd5\xc5\x05\xa4\x03\xe0\x35\x02\xe2\x84\x4f\x3b\xc4\x79\x59\x05\x57\xbb\xfa\xbf\xfb\x92\x87\x9a\x63\xa7\xca\x1a\xbf\x3d\xf5\x77\x78\x9f\x72\xca\x94\xf0\xdd\x92\x56\xff\xc2\x4c\x54\x1f\x49\x04\x51\xc7\x30\xe5\x41\xf1\x8c\xde\x9a\x89\xb2\xf3\xf9\x02\xa2\x5a\x4a\xd5\xd1\xe1\xf8\x70\x32\x1a\xfe\x72\xd1\x1f\x4c\xce\x0e\x4f\x07\xc5\x18\x86\xfd\xd9\xee\x5e\xbd\x84\xfc\xc6\x3f\xeb\x9d\x67\xdf\x35\xdb\xf0\x5f\xa7\x8b\x51\xa8\xd1\xba\x37\x94\xe5\x44\x5c\x08\x66\xdf\x77\xda\x7f\xb7\x3d\xb7\x2e\x7f\x92\x13\x4b\x13\x4b\x59\x75\x90\x0f\x52\xa5\x0f\x1f\x9c\xa4\x30\xdd\x43\xda\x0e\xc8\xde\xfb\x98\x86\xca\x57\x2b\xd2\xed\x61\x16\x6b\x16\xe5\x05\x0a\x34\x69\x78\x18\xd7\xc2\xdf\xac\x2a\x00\x3a\x65\x77\xa3\x8e\x56\xc4\x13\x7f\xe1\xe7\x71\x07\x7a\xec\x41\x44\x96\xa1\xf9\x33\x48\x7c\xe0\x3b\x9f\xf4\x74\x37\xa9\xf9\x1f\xea\x65\x7f\x01\xe6\x53\xa1\xde\x25\x02\x28\x4b\xd7\xbe\xfb\xab\x5a\xd0\x6e\xbe\x1a\x7c\x94\x29\xac\x8e\x18\x38\xac\x05\x90\x42\xa6\x7e\x88\x31\x21\xe2\xd2\xd7\x6f\x3b\xf8\xcc\x14\x9d\x5e\xd2\x8b\x04\x1d\xa5\xe1\xaa\xd8\x55\x86\xda\x7a\xc3\xe3\x44\x3f\x86\x99\xf8\xe1\x8c\x97\x77\x04\x1e\x93\x7f\x01\x70\x52\xc0\xa4\x65\xec\x75\xf2\xb6\xca\x23\x14\x6f\xc8\x30\x06\x93\x5a\xff\xf5\xe1\xd9\xab\x01\x39\x3d\x1c\x8d\x07\x17\x64\x3c\xac\x99\xd7\x00\x39\x6c\x50\xe5\x53\x6c\x66\xde\xb2\x7d\x61\xaa\x64\x7d\x25\x83\x65\xac\xce\x34\x92\x76\xa6\x79\xa3\x93\x0d\x11\xcb\x0a\xba\x5d\xfd\xbc\x71\x62\x0a\xf8\x89\xe2\x4d\x10\x5a\xd3\xcf\xb3\x71\x7c\x1d\x62\xbb\xd1\x42\x61\x04\x68\x70\xf8\x49\x43\x45\xf7\xc8\xea\xbe\xda\x97\x24\xc6\xb7\xc6\x52\xe6\x92\x19\xec\x5f\xcd\x2a\xb0\x64\xde\x21\x95\x09\xdb\xde\xb1\x42\xda\x6f\x33\x72\x31\x3d\x46\x28\xdc\xb5\x80\x92\xb6\x28\x39\xa7\x42\x16\xce\x3f\x4b\x26\x5e\x64\x60\xf1\x5f\xbe\xb9\xbe\xda\xf8\x77\xbd\xf9\xe4\x1f\x8d\xcb\xcb\xae\x8c\xa8\xcb\xba\x57\x57\x4f\xf5\x40\xe5\x45\x87\xe6\xfd\x5e\xc6\xd3\xae\x73\x5e\x1b\x92\x4f\x93\x93\xe1\xab\xc9\xcb\xe3\x93\x41\x6f\xbf\x78\x61\xb2\x7f\x50\xec\x1c\x11\x7b\xe1\xf9\x70\x94\xbf\x67\x79\x76\xf5\x09\x6f\x14\xef\xd7\x81\x99\x9f\x35\x24\x93\xe1\xc2\x29\x41\x92\x6b\xde\xf4\x70\x34\xe5\x08\x5f\x42\x83\x5a\x9b\xfe\xcd\x74\x45\xf4\xc5\x2f\x9e\x45\x72\xf3\x9b\x97\xf5\xfa\x9c\x2b\x48\x28\x3f\x68\x23\xa4\xb7\xd6\x8d\xa4\xc9\x49\xcc\x65\xa4\xb9\x88\x24\x75\xea\xba\x2c\xd2\x10\xe0\x80\x42\xd0\x04\x7c\x39\x9a\xeb\xc2\x9b\x7b\xe8\xf5\xbd\xec\x3a\x9a\xef\x7c\x1f\x8b\xdd\xe4\xf4\x32\xf5\xb9\xe9\xb7\x96\x90\x7a\x6c\x52\x6d\xff\xf7\x9c\x28\x92\x77\x7f\x5a\x5a\xa9\x2a\xd6\xf2\xa6\x17\x1a\xbb\x42\x0f\xb5\x7e\x01\x85\x6d\x0f\x90\xec\x01\xa1\x0a\xdf\x8a\x2b\x63\x7f\x54\x39\x0b\x2e\x95\xc3\xb1\x91\x91\x37\xff\x2a\x09\x97\x8e\x73\x91\x79\x73\x42\x1e\x28\xa2\x17\x2f\x06\xc3\x97\xd6\xfa\xbd\xfa\x8b\xca\x6d\x1a\x07\x8f\x4a\x14\xf6\xf5\x70\x34\xee\xed\xef\xfc\xf6\x60\xbf\x14\xc9\x2f\xa3\xc1\x45\x6f\x1f\xf3\xb7\xf2\xf9\x94\x62\x34\xa7\x32\x46\xca\x57\xf5\x87\x67\x67\x70\xf8\x60\x44\xe3\x8b\x77\xbd\x4e\xdb\xee\xc2\x8f\xc6\x87\x17\x63\x32\x3a\x39\x7c\x33\xb0\x87\xb3\xd2\xc8\x1b\x93\x7e\x82\x00\x56\x93\x3c\x3a\xc4\x27\x5f\x98\x5d\x18\x4f\x21\xf5\x0f\x0a\xd2\x14\x0e\x2c\x0d\xac\x0a\x3b\x95\x99\x3e\x28\xd6\x98\x69\xbf\x32\xd0\x3f\x71\x80\x0f\x78\xd5\xe6\x60\x47\x1a\x3e\x23\x42\xd3\xc4\x74\x9c\x05\xbd\xc3\xd7\x42\xa1\xec\x75\x4c\x6b\xd2\x71\x73\x4d\xe7\x5a\xa6\xc5\xb8\xfe\xa0\xa3\x86\xa3\xfd\x2f\x7c\xd1\xbd\xcc\xde\xa6\xa9\xe9\xe0\xd5\x6f\xcf\x52\x0f\x07\x62\x80\xe8\x99\xd7\x0d\x4e\x9a\x40\xf4\x7e\xab\x50\x9a\xdf\x6a\xb5\x2f\xaa\x87\xf3\xf0\x9a\xad\xaa\x68\x6b\xb7\xd7\x55\x9b\x61\xbc\xd0\x59\xcc\xd0\x97\xbe\x0b\x86\xd4\xed\xd3\xa3\x7b\xdf\xa9\xb9\xe9\x9b\x33\x9b\xa4\x62\x57\xed\x33\x5e\xaa\x6d\x7f\xa7\xb6\x65\x5f\xbb\x14\xcd\x6e\xa4\x6f\xc0\x2a\x27\x92\x6b\x5e\xf8\x64\x26\x73\x44\xe6\x3c\xc2\x0e\x4f\xf3\xb7\xf6\xe0\xb6\x75\xe0\x76\x64\x6e\x83\x76\xc3\x8e\xcd\x63\xf5\xfc\x86\xd5\x0d\xcc\x9f\xc1\xee\x7d\x7d\xf5\xaa\xae\x7a\xe9\x2f\xa7\x6c\xe6\x5d\x1c\x58\xff\x74\xe8\xfc\x4d\xdf\x10\x99\xbf\x12\xb4\x7f\x51\x54\x4e\xeb\x72\x83\x7f\x9c\xfc\x08\x4a\x9b\x83\x53\xf8\x15\x54\xc1\x19\x3c\xf8\x67\x51\x98\x20\x48\x79\xca\x3d\xd8\x82\x5c\xd6\xb0\x83\xf6\x16\x2f\xed\x87\x40\xeb\xba\x0b\x94\xe9\x68\x81\x50\xa0\x86\x03\xea\xa4\xcc\xfd\x7c\x2a\x37\x63\xd7\x55\x95\x8b\xf2\x67\x54\xe2\x52\xca\x1c\x4a\xb2\x57\x71\x0b\xc4\xf4\x3f\x81\x0d\xf7\x74\xd7\x38\x00\x00")
 
 func artifactsClusterStatefulsetYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -298,6 +510,46 @@ func artifactsClusterStatefulsetYaml() (*asset, error) {
 	return a, nil
 }
 
+var _artifactsClusterValidatingadmissionpolicyYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa5\x92\x41\x4f\xdc\x30\x10\x85\xef\xf9\x15\x43\x0e\x2c\x48\xc5\xd5\xde\x20\x6a\x2a\x21\x4a\x7b\x2c\x42\x14\x0e\x88\xc3\xac\x33\x64\x5d\xec\x38\x78\x9c\x6d\x91\xf8\xf1\x1d\x3b\xd9\x95\x16\x22\xb5\x52\xa5\x1c\x9c\x79\xef\x8d\x3f\x8f\x8d\xbd\xb9\xa5\xc0\xc6\x77\x15\x60\xe3\x0c\xa7\x65\xa0\xd6\x70\x0c\x18\x65\xad\x9e\x4e\x59\x19\xff\x71\xb3\x2c\x9e\x4c\xd7\x54\x70\x8b\xd6\x34\x22\x75\xed\xf9\xd6\x7f\xe5\xad\xd1\x2f\x85\xa3\x88\xa2\x60\x55\x00\x74\xe8\xa8\x02\xf7\xc2\xcf\x56\xdb\x81\xa3\xec\xa1\x74\x50\xb9\xe0\x7b\x92\xde\x3e\xa8\x36\x44\xab\x5a\x13\xd7\xc3\x4a\x69\xef\x0a\xee\x49\xa7\xf0\x23\x1a\x3b\x04\x1a\xdb\x56\xf0\x55\x7e\xa5\xea\x30\xea\xf5\x85\xef\x12\x99\xe9\x22\x27\x27\x40\x20\xf6\x43\xd0\x74\x3d\x58\x9a\x4a\x27\x80\xbd\xf9\x16\xfc\xd0\x73\x05\xf7\xe5\xdf\xb6\x2d\x1f\x72\x0a\x52\x6a\x9a\x45\xce\x6d\x96\x3b\x65\x8c\x6e\x85\x8b\xeb\xcb\xf3\x9b\xcb\xf2\x03\x94\x3f\xae\xbe\xa4\xd5\xd6\xb6\x65\xc9\xae\xbd\xb3\x67\xcb\x66\x9a\x5c\x6a\x53\x24\x4c\xfa\xdd\x4b\x64\x1c\x7e\x79\xb0\x46\x3e\xf2\xab\x9f\xa4\xa3\x4a\x83\x50\x81\x7a\x39\x3f\xf2\x31\xbc\xbe\xc2\xac\x02\x9f\x6b\x58\xc2\xe1\x21\xcc\x8a\x9f\x6a\x38\x3b\x2e\x33\x9a\x93\x5d\xb0\x95\x0b\x29\xf7\x2d\x4e\xe8\x60\x45\xf2\xc5\x5f\x44\x9d\x34\xc3\xae\x81\xb3\xf2\x1f\xe8\x8c\x93\x86\x19\xed\x5d\x55\x71\xc4\x10\xf9\x4e\x26\x7c\xb4\xc8\x63\xa8\x16\xf3\x20\xd9\xbe\xa3\xc0\xf1\xbd\x40\xae\xce\x30\x04\x7a\x1e\x88\xa3\xda\xdd\x06\x1c\xd4\xb0\x18\xef\x60\xf1\x96\x84\x49\x07\x8a\x50\xd7\xe0\x6d\xf3\xfd\x9d\x30\x8b\x33\x65\x0c\x0b\x82\x1b\x22\xae\xec\xff\x63\xc8\x83\x4b\x67\x9c\xe1\x18\x95\x79\x90\x29\xb5\x4f\xf2\x07\xc4\xa7\x6d\x63\xac\x03\x00\x00")
+
+func artifactsClusterValidatingadmissionpolicyYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterValidatingadmissionpolicyYaml,
+		"artifacts/cluster-validatingadmissionpolicy.yaml",
+	)
+}
+
+func artifactsClusterValidatingadmissionpolicyYaml() (*asset, error) {
+	bytes, err := artifactsClusterValidatingadmissionpolicyYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-validatingadmissionpolicy.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _artifactsClusterValidatingadmissionpolicybindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x4e\xbb\x0e\xc2\x30\x10\xdb\xf3\x15\xa7\x7e\x40\x10\x1b\xca\x56\xc4\x8c\x98\xba\x20\x86\x23\x8d\xc2\xa9\x79\x91\x4b\x2b\xf5\xef\xb9\x56\xf0\x03\x2c\x27\xcb\xf6\xd9\xc6\x42\x83\xab\x4c\x39\x19\xc0\x31\x12\x6f\xb0\x3a\x4f\xdc\x2a\x36\xc1\x7a\x3a\xb1\xa6\x7c\x58\x8e\x6a\xa2\x34\x1a\x18\x30\xd0\x28\x52\xf2\xfd\xcf\x7f\xcb\x81\xec\x7a\x16\x59\x58\x15\x5d\x43\x31\xa0\x51\x00\x09\xa3\x33\x10\x57\x7e\x07\x1b\x66\x6e\x52\xa5\x6d\xd5\x3b\x91\x8b\x93\x8a\x5c\xb5\xaf\x2d\x68\x4f\xed\x35\x3f\xb5\xcd\x51\x71\x71\x76\x7b\x2e\x7b\xec\xf5\x9f\x08\x80\xe5\x3b\x33\xa7\xde\x6e\x97\x0d\xdc\xbb\x8b\x4b\x6b\xf7\x50\x1f\x7d\xa1\x3a\xc6\xf5\x00\x00\x00")
+
+func artifactsClusterValidatingadmissionpolicybindingYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_artifactsClusterValidatingadmissionpolicybindingYaml,
+		"artifacts/cluster-validatingadmissionpolicybinding.yaml",
+	)
+}
+
+func artifactsClusterValidatingadmissionpolicybindingYaml() (*asset, error) {
+	bytes, err := artifactsClusterValidatingadmissionpolicybindingYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "artifacts/cluster-validatingadmissionpolicybinding.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -350,17 +602,29 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"artifacts/backupinstance-crd.yaml":        artifactsBackupinstanceCrdYaml,
-	"artifacts/backupinstance-job-create.yaml": artifactsBackupinstanceJobCreateYaml,
-	"artifacts/backupinstance-job-delete.yaml": artifactsBackupinstanceJobDeleteYaml,
-	"artifacts/backupschedule-crd.yaml":        artifactsBackupscheduleCrdYaml,
-	"artifacts/backupschedule-cronjob.yaml":    artifactsBackupscheduleCronjobYaml,
-	"artifacts/backupschedule-pvc.yaml":        artifactsBackupschedulePvcYaml,
-	"artifacts/cluster-configmap.yaml":         artifactsClusterConfigmapYaml,
-	"artifacts/cluster-crd.yaml":               artifactsClusterCrdYaml,
-	"artifacts/cluster-service-read.yaml":      artifactsClusterServiceReadYaml,
-	"artifacts/cluster-service.yaml":           artifactsClusterServiceYaml,
-	"artifacts/cluster-statefulset.yaml":       artifactsClusterStatefulsetYaml,
+	"artifacts/backupinstance-crd.yaml":                       artifactsBackupinstanceCrdYaml,
+	"artifacts/backupinstance-job-cleanup.yaml":               artifactsBackupinstanceJobCleanupYaml,
+	"artifacts/backupinstance-job-create.yaml":                artifactsBackupinstanceJobCreateYaml,
+	"artifacts/backupinstance-job-delete.yaml":                artifactsBackupinstanceJobDeleteYaml,
+	"artifacts/backupinstance-job-snapshot.yaml":              artifactsBackupinstanceJobSnapshotYaml,
+	"artifacts/backupschedule-crd.yaml":                       artifactsBackupscheduleCrdYaml,
+	"artifacts/backupschedule-cronjob.yaml":                   artifactsBackupscheduleCronjobYaml,
+	"artifacts/backupschedule-pvc.yaml":                       artifactsBackupschedulePvcYaml,
+	"artifacts/cluster-configmap-config.yaml":                 artifactsClusterConfigmapConfigYaml,
+	"artifacts/cluster-configmap-init.yaml":                   artifactsClusterConfigmapInitYaml,
+	"artifacts/cluster-configmap-proxysql.yaml":               artifactsClusterConfigmapProxysqlYaml,
+	"artifacts/cluster-configmap.yaml":                        artifactsClusterConfigmapYaml,
+	"artifacts/cluster-crd.yaml":                              artifactsClusterCrdYaml,
+	"artifacts/cluster-job-migration.yaml":                    artifactsClusterJobMigrationYaml,
+	"artifacts/cluster-proxy-deployment.yaml":                 artifactsClusterProxyDeploymentYaml,
+	"artifacts/cluster-service-metrics.yaml":                  artifactsClusterServiceMetricsYaml,
+	"artifacts/cluster-service-proxy.yaml":                    artifactsClusterServiceProxyYaml,
+	"artifacts/cluster-service-read.yaml":                     artifactsClusterServiceReadYaml,
+	"artifacts/cluster-service.yaml":                          artifactsClusterServiceYaml,
+	"artifacts/cluster-servicemonitor.yaml":                   artifactsClusterServicemonitorYaml,
+	"artifacts/cluster-statefulset.yaml":                      artifactsClusterStatefulsetYaml,
+	"artifacts/cluster-validatingadmissionpolicy.yaml":        artifactsClusterValidatingadmissionpolicyYaml,
+	"artifacts/cluster-validatingadmissionpolicybinding.yaml": artifactsClusterValidatingadmissionpolicybindingYaml,
 }
 
 // AssetDir returns the file names below a certain
@@ -405,17 +669,29 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"artifacts": {nil, map[string]*bintree{
-		"backupinstance-crd.yaml":        {artifactsBackupinstanceCrdYaml, map[string]*bintree{}},
-		"backupinstance-job-create.yaml": {artifactsBackupinstanceJobCreateYaml, map[string]*bintree{}},
-		"backupinstance-job-delete.yaml": {artifactsBackupinstanceJobDeleteYaml, map[string]*bintree{}},
-		"backupschedule-crd.yaml":        {artifactsBackupscheduleCrdYaml, map[string]*bintree{}},
-		"backupschedule-cronjob.yaml":    {artifactsBackupscheduleCronjobYaml, map[string]*bintree{}},
-		"backupschedule-pvc.yaml":        {artifactsBackupschedulePvcYaml, map[string]*bintree{}},
-		"cluster-configmap.yaml":         {artifactsClusterConfigmapYaml, map[string]*bintree{}},
-		"cluster-crd.yaml":               {artifactsClusterCrdYaml, map[string]*bintree{}},
-		"cluster-service-read.yaml":      {artifactsClusterServiceReadYaml, map[string]*bintree{}},
-		"cluster-service.yaml":           {artifactsClusterServiceYaml, map[string]*bintree{}},
-		"cluster-statefulset.yaml":       {artifactsClusterStatefulsetYaml, map[string]*bintree{}},
+		"backupinstance-crd.yaml":                       {artifactsBackupinstanceCrdYaml, map[string]*bintree{}},
+		"backupinstance-job-cleanup.yaml":               {artifactsBackupinstanceJobCleanupYaml, map[string]*bintree{}},
+		"backupinstance-job-create.yaml":                {artifactsBackupinstanceJobCreateYaml, map[string]*bintree{}},
+		"backupinstance-job-delete.yaml":                {artifactsBackupinstanceJobDeleteYaml, map[string]*bintree{}},
+		"backupinstance-job-snapshot.yaml":              {artifactsBackupinstanceJobSnapshotYaml, map[string]*bintree{}},
+		"backupschedule-crd.yaml":                       {artifactsBackupscheduleCrdYaml, map[string]*bintree{}},
+		"backupschedule-cronjob.yaml":                   {artifactsBackupscheduleCronjobYaml, map[string]*bintree{}},
+		"backupschedule-pvc.yaml":                       {artifactsBackupschedulePvcYaml, map[string]*bintree{}},
+		"cluster-configmap-config.yaml":                 {artifactsClusterConfigmapConfigYaml, map[string]*bintree{}},
+		"cluster-configmap-init.yaml":                   {artifactsClusterConfigmapInitYaml, map[string]*bintree{}},
+		"cluster-configmap-proxysql.yaml":               {artifactsClusterConfigmapProxysqlYaml, map[string]*bintree{}},
+		"cluster-configmap.yaml":                        {artifactsClusterConfigmapYaml, map[string]*bintree{}},
+		"cluster-crd.yaml":                              {artifactsClusterCrdYaml, map[string]*bintree{}},
+		"cluster-job-migration.yaml":                    {artifactsClusterJobMigrationYaml, map[string]*bintree{}},
+		"cluster-proxy-deployment.yaml":                 {artifactsClusterProxyDeploymentYaml, map[string]*bintree{}},
+		"cluster-service-metrics.yaml":                  {artifactsClusterServiceMetricsYaml, map[string]*bintree{}},
+		"cluster-service-proxy.yaml":                    {artifactsClusterServiceProxyYaml, map[string]*bintree{}},
+		"cluster-service-read.yaml":                     {artifactsClusterServiceReadYaml, map[string]*bintree{}},
+		"cluster-service.yaml":                          {artifactsClusterServiceYaml, map[string]*bintree{}},
+		"cluster-servicemonitor.yaml":                   {artifactsClusterServicemonitorYaml, map[string]*bintree{}},
+		"cluster-statefulset.yaml":                      {artifactsClusterStatefulsetYaml, map[string]*bintree{}},
+		"cluster-validatingadmissionpolicy.yaml":        {artifactsClusterValidatingadmissionpolicyYaml, map[string]*bintree{}},
+		"cluster-validatingadmissionpolicybinding.yaml": {artifactsClusterValidatingadmissionpolicybindingYaml, map[string]*bintree{}},
 	}},
 }}
 
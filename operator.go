@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/config"
 	"github.com/grtl/mysql-operator/pkg/controller"
 	"github.com/grtl/mysql-operator/pkg/controller/backupinstance"
 	"github.com/grtl/mysql-operator/pkg/controller/backupschedule"
@@ -23,6 +24,8 @@ import (
 	backupinstancecrd "github.com/grtl/mysql-operator/pkg/crd/backupinstance"
 	backupschedulecrd "github.com/grtl/mysql-operator/pkg/crd/backupschedule"
 	clustercrd "github.com/grtl/mysql-operator/pkg/crd/cluster"
+	"github.com/grtl/mysql-operator/pkg/metrics"
+	backupoperator "github.com/grtl/mysql-operator/pkg/operator/backupinstance"
 	operator "github.com/grtl/mysql-operator/pkg/operator/cluster"
 )
 
@@ -42,10 +45,13 @@ var (
 func main() {
 	flag.Parse()
 
+	logrus.SetLevel(config.Get().LogLevel)
 	if *debug {
 		logrus.SetLevel(logrus.DebugLevel)
 	}
 
+	metrics.Serve()
+
 	config, err := clientcmd.BuildConfigFromFlags(*master, *kubeconfig)
 	if err != nil {
 		logrus.WithError(err).Fatal("Unable to build config")
@@ -67,7 +73,10 @@ func main() {
 	defer cancelFunc()
 
 	logrus.Debug("Starting the cluster controller")
-	clusterController := cluster.NewClusterController(clientset, kubeClientset)
+	clusterController, err := cluster.NewClusterControllerForConfig(clientset, kubeClientset, config)
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to create the cluster controller")
+	}
 	go runController(clusterController, ctx)
 
 	logrus.Debug("Starting the backup schedule controller")
@@ -131,5 +140,18 @@ func initializeObjects() error {
 		return err
 	}
 
-	return operator.CreateConfigMap(*namespace, kubeClientset)
+	err = operator.CreateConfigMap(*namespace, kubeClientset)
+	if err != nil {
+		return err
+	}
+
+	// Upgrades may change the schema shape - re-write stored clusters which
+	// predate it.
+	err = operator.MigrateStoredClusters(*namespace, clientset)
+	if err != nil {
+		return err
+	}
+
+	// Pick up backup jobs a previous operator instance left running.
+	return backupoperator.ReconcileOrphanedJobs(*namespace, clientset, kubeClientset)
 }
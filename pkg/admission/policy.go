@@ -0,0 +1,65 @@
+// Package admission installs CEL-based admission policies validating the
+// operator's custom resources. It is a webhook-free alternative for clusters
+// serving the ValidatingAdmissionPolicy API.
+package admission
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/grtl/mysql-operator/pkg/util"
+)
+
+const (
+	policyTemplate  = "artifacts/cluster-validatingadmissionpolicy.yaml"
+	bindingTemplate = "artifacts/cluster-validatingadmissionpolicybinding.yaml"
+)
+
+// policyGroupVersion is the API group serving ValidatingAdmissionPolicy
+// objects.
+var policyGroupVersion = schema.GroupVersion{
+	Group:   "admissionregistration.k8s.io",
+	Version: "v1",
+}
+
+// InstallClusterPolicy installs the admission policy validating MySQLCluster
+// objects together with its binding.
+func InstallClusterPolicy(config *rest.Config) error {
+	confCopy := *config
+	confCopy.GroupVersion = &policyGroupVersion
+	confCopy.APIPath = "/apis"
+
+	policyClient, err := dynamic.NewClient(&confCopy)
+	if err != nil {
+		return err
+	}
+
+	err = createPolicyObject(policyClient, policyTemplate, "validatingadmissionpolicies")
+	if err != nil {
+		return err
+	}
+
+	return createPolicyObject(policyClient, bindingTemplate, "validatingadmissionpolicybindings")
+}
+
+// createPolicyObject creates the cluster-scoped object from the given
+// artifact, tolerating an already existing one.
+func createPolicyObject(policyClient dynamic.Interface, filename, resourceName string) error {
+	object := new(unstructured.Unstructured)
+	err := util.ObjectFromFile(filename, object)
+	if err != nil {
+		return err
+	}
+
+	resource := &metav1.APIResource{Name: resourceName, Namespaced: false}
+	_, err = policyClient.Resource(resource, "").Create(object)
+	if err != nil && apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+
+	return err
+}
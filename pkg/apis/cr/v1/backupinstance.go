@@ -19,6 +19,38 @@ type MySQLBackupInstance struct {
 // MySQLBackupInstanceStatus represents a backup instance status.
 type MySQLBackupInstanceStatus struct {
 	Phase MySQLBackupInstanceStatusPhase `json:"phase"`
+	// Message explains the phase, e.g. why the backup failed.
+	Message string `json:"message,omitempty"`
+	// SnapshotName is the name of the VolumeSnapshot taken for a snapshot
+	// backup.
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// Destinations records the upload result per storage destination.
+	Destinations map[string]string `json:"destinations,omitempty"`
+	// SourceCluster is the name of the cluster the backup was taken from.
+	SourceCluster string `json:"sourceCluster,omitempty"`
+	// SourceNamespace is the namespace of the cluster the backup was taken
+	// from.
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+	// SourceVersion is the MySQL version the backup was taken from. A restore
+	// can use it to refuse incompatible targets.
+	SourceVersion string `json:"sourceVersion,omitempty"`
+	// BackupTime is when the backup was started.
+	BackupTime metav1.Time `json:"backupTime,omitempty"`
+	// EncryptionKeySecret is the name of the secret holding the key the
+	// embedded cluster secret was encrypted with. A restore decrypts with
+	// this key, so rotating the key does not orphan older backups.
+	EncryptionKeySecret string `json:"encryptionKeySecret,omitempty"`
+	// EncryptionKeyVersion is the resource version the key secret had at
+	// encryption time.
+	EncryptionKeyVersion string `json:"encryptionKeyVersion,omitempty"`
+	// CleanupStatus records the outcome of removing the partial artifact
+	// after the backup failed.
+	CleanupStatus string `json:"cleanupStatus,omitempty"`
+	// Format is the logical export format the backup additionally produced,
+	// sql or csv. Empty means the physical backup only.
+	Format string `json:"format,omitempty"`
+	// ExportedTables lists the tables exported when Format is csv.
+	ExportedTables []string `json:"exportedTables,omitempty"`
 }
 
 // MySQLBackupInstanceStatusPhase represents a backup instance phase.
@@ -32,10 +64,46 @@ const (
 	MySQLBackupCompleted MySQLBackupInstanceStatusPhase = "Completed"
 )
 
+// MySQLBackupSource determines which cluster member a backup is taken from.
+type MySQLBackupSource string
+
+// Available MySQLBackupSource values.
+const (
+	// BackupSourcePrimary takes the backup from the primary member.
+	BackupSourcePrimary MySQLBackupSource = "primary"
+	// BackupSourceReplica takes the backup from a replica and fails when no
+	// replica is available.
+	BackupSourceReplica MySQLBackupSource = "replica"
+	// BackupSourcePreferReplica takes the backup from a replica, falling back
+	// to the primary when no replica is available.
+	BackupSourcePreferReplica MySQLBackupSource = "preferReplica"
+)
+
+// MySQLBackupMethod determines how a backup is taken.
+type MySQLBackupMethod string
+
+// Available MySQLBackupMethod values.
+const (
+	// BackupMethodCopy streams the data files from the source member onto the
+	// backup volume.
+	BackupMethodCopy MySQLBackupMethod = "copy"
+	// BackupMethodSnapshot quiesces a replica and takes a VolumeSnapshot of
+	// its data volume.
+	BackupMethodSnapshot MySQLBackupMethod = "snapshot"
+)
+
 // MySQLBackupInstanceSpec stores the properties of a backup.
 type MySQLBackupInstanceSpec struct {
 	Schedule string `json:"schedule"`
 	Cluster  string `json:"cluster"`
+	// Source selects the cluster member to back up. Defaults to the primary.
+	Source MySQLBackupSource `json:"source,omitempty"`
+	// Method selects how the backup is taken. Defaults to copy.
+	Method MySQLBackupMethod `json:"method,omitempty"`
+	// BackupTimeoutSeconds bounds this backup's job, so a dump hung on a
+	// long-running transaction cannot hold its locks indefinitely. It
+	// overrides the schedule's activeDeadlineSeconds for this instance.
+	BackupTimeoutSeconds *int64 `json:"backupTimeoutSeconds,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -14,7 +15,17 @@ type MySQLBackupSchedule struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 
-	Spec MySQLBackupScheduleSpec `json:"spec"`
+	Spec   MySQLBackupScheduleSpec   `json:"spec"`
+	Status MySQLBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// MySQLBackupScheduleStatus represents a backup schedule's status.
+type MySQLBackupScheduleStatus struct {
+	// StorageReachable reports whether every storage destination responded
+	// to the last probe. It is unset until the first probe.
+	StorageReachable *bool `json:"storageReachable,omitempty"`
+	// StorageMessage explains an unreachable destination.
+	StorageMessage string `json:"storageMessage,omitempty"`
 }
 
 // MySQLBackupScheduleSpec stores the properties of a backup schedule.
@@ -22,6 +33,73 @@ type MySQLBackupScheduleSpec struct {
 	Cluster string            `json:"cluster"`
 	Time    string            `json:"time"`
 	Storage resource.Quantity `json:"storage"`
+	// StorageClassName selects the storage class of the backup volume claim.
+	// Empty uses the namespace default class.
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// Destinations lists object storage buckets every backup is uploaded to,
+	// in addition to the backup volume.
+	Destinations []MySQLBackupDestination `json:"destinations,omitempty"`
+	// Resources are the compute resources of the backup job pods. Requests
+	// default to modest values when unset, so the jobs aren't first in line
+	// for eviction on memory-pressured nodes.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// IncludeSecret embeds the cluster's credentials secret into the backup
+	// artifact, always encrypted, so a disaster recovery restore can
+	// recreate it. Requires SecretEncryptionKeyRef.
+	IncludeSecret bool `json:"includeSecret,omitempty"`
+	// SecretEncryptionKeyRef references a secret whose "key" entry holds the
+	// passphrase the embedded credentials secret is encrypted with.
+	SecretEncryptionKeyRef *MySQLBackupSecretRef `json:"secretEncryptionKeyRef,omitempty"`
+	// Format additionally exports the data in a logical format alongside
+	// the physical backup - sql for a full dump, csv for per-table exports
+	// of the listed tables. Empty keeps the physical backup only.
+	Format string `json:"format,omitempty"`
+	// CSVTables lists the tables exported when Format is csv, as
+	// database.table names.
+	CSVTables []string `json:"csvTables,omitempty"`
+	// BackoffLimit is how many times a failed backup job is retried before
+	// it is marked failed. Defaults to 3.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds bounds a backup job's runtime, so a hung backup
+	// cannot linger forever. Defaults to 6 hours.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// Validate checks the schedule for configuration errors the API server
+// cannot catch, the cron expression in particular.
+func (s *MySQLBackupSchedule) Validate() error {
+	return ValidateCronExpression(s.Spec.Time)
+}
+
+// Known values of the backup Format spec field.
+const (
+	// BackupFormatSQL dumps the data as a single SQL file.
+	BackupFormatSQL = "sql"
+	// BackupFormatCSV exports the listed tables as CSV files.
+	BackupFormatCSV = "csv"
+)
+
+// MySQLBackupDestination describes an object storage bucket backups are
+// uploaded to.
+type MySQLBackupDestination struct {
+	// Name identifies the destination in the backup instance status.
+	Name string `json:"name"`
+	// URL is the bucket URL the dump is uploaded to, e.g. s3://bucket/path.
+	URL string `json:"url"`
+	// BestEffort marks the destination as optional - a failed upload does not
+	// fail the backup.
+	BestEffort bool `json:"bestEffort,omitempty"`
+	// Secret references the secret holding the destination credentials.
+	Secret *MySQLBackupSecretRef `json:"secret,omitempty"`
+}
+
+// MySQLBackupSecretRef references a secret holding storage credentials.
+type MySQLBackupSecretRef struct {
+	Name string `json:"name"`
+	// Namespace is the secret's namespace when it differs from the backup's.
+	// Cross-namespace references have to be allowed in the operator
+	// configuration.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,63 @@
+package v1_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("MySQLBackupSchedule", func() {
+	Describe("Validate", func() {
+		schedule := func(time string) *crv1.MySQLBackupSchedule {
+			schedule := new(crv1.MySQLBackupSchedule)
+			schedule.Name = "my-backup"
+			schedule.Spec.Time = time
+			return schedule
+		}
+
+		It("accepts a standard 5-field expression", func() {
+			Expect(schedule("30 4 * * 1-5").Validate()).To(Succeed())
+		})
+
+		It("rejects a 6-field expression with seconds", func() {
+			err := schedule("0 30 4 * * *").Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected 5 fields"))
+		})
+
+		It("accepts lists, steps and names", func() {
+			Expect(schedule("*/15 0,12 1 jan-mar mon").Validate()).To(Succeed())
+		})
+
+		It("accepts the shorthand descriptors", func() {
+			Expect(schedule("@daily").Validate()).To(Succeed())
+		})
+
+		It("rejects an expression with too few fields", func() {
+			err := schedule("30 4 * *").Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected 5 fields"))
+		})
+
+		It("names the offending field", func() {
+			err := schedule("30 25 * * *").Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`"30 25 * * *"`))
+			Expect(err.Error()).To(ContainSubstring("field 2 (hour)"))
+			Expect(err.Error()).To(ContainSubstring(`bad value "25"`))
+		})
+
+		It("rejects an inverted range", func() {
+			err := schedule("30 4 * * 5-1").Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`bad range "5-1"`))
+		})
+
+		It("rejects a zero step", func() {
+			err := schedule("*/0 * * * *").Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`bad step "0"`))
+		})
+	})
+})
@@ -1,8 +1,20 @@
 package v1
 
 import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Default values for optional fields.
@@ -10,8 +22,119 @@ const (
 	DefaultReplicas int32 = 2
 	DefaultPort     int32 = 3306
 	DefaultImage          = "mysql:latest"
+	// DefaultMetricsScrapeInterval is the scrape interval advertised for the
+	// metrics service when none is specified.
+	DefaultMetricsScrapeInterval = "30s"
+	// DefaultFSGroupChangePolicy avoids the recursive chown of the whole data
+	// volume on mount.
+	DefaultFSGroupChangePolicy = "OnRootMismatch"
+	// DefaultStorage is the size of the data volume claimed for each replica
+	// when none is specified.
+	DefaultStorage = "10Gi"
+	// DefaultMinReadySeconds is how long a pod has to stay ready before a
+	// rolling update advances past it.
+	DefaultMinReadySeconds int32 = 10
+	// DefaultDNSNdots keeps connection-heavy clients from walking the whole
+	// DNS search path: the cluster default ndots:5 turns every service lookup
+	// into several failed queries before the right one.
+	DefaultDNSNdots = "2"
+	// DefaultReplicaStartupTimeoutSeconds is how long a replica waits for the
+	// primary to accept queries before giving up and restarting.
+	DefaultReplicaStartupTimeoutSeconds int32 = 300
+	// DefaultProxyReplicas is the number of ProxySQL pods deployed when the
+	// proxy is enabled without a size.
+	DefaultProxyReplicas int32 = 1
+	// DefaultProxyImage is the ProxySQL image deployed when none is
+	// specified.
+	DefaultProxyImage = "proxysql/proxysql:latest"
+	// DefaultTimeZone keeps server timestamps independent of the node's
+	// zone.
+	DefaultTimeZone = "UTC"
+)
+
+// SchemaVersion marks the schema shape the operator writes. Stored objects
+// carrying an older marker are re-normalized and re-written at operator
+// startup; bump it when the schema changes in a way stored objects should
+// pick up.
+const SchemaVersion = "2"
+
+// Known values of the RestoreOverwritePolicy spec field.
+const (
+	// RestoreFail refuses to restore over existing data.
+	RestoreFail = "fail"
+	// RestoreOverwrite discards existing data before the restore.
+	RestoreOverwrite = "overwrite"
+	// RestoreSkipIfExists keeps existing data and skips the restore.
+	RestoreSkipIfExists = "skipIfExists"
+)
+
+// Known values of the UpdateStrategy spec field.
+const (
+	// UpdateRollingUpdate rolls template changes out automatically.
+	UpdateRollingUpdate = "RollingUpdate"
+	// UpdateOnDelete applies template changes only as pods are deleted.
+	UpdateOnDelete = "OnDelete"
+)
+
+// Known values of the Flavor spec field.
+const (
+	FlavorMySQL   = "mysql"
+	FlavorMariaDB = "mariadb"
+	FlavorPercona = "percona"
+)
+
+// DefaultFlavor is the flavor assumed when none is specified.
+const DefaultFlavor = FlavorMySQL
+
+// Flavors lists the known MySQL flavors.
+var Flavors = []string{FlavorMySQL, FlavorMariaDB, FlavorPercona}
+
+// Versions lists the server versions the default images may be pinned to via
+// the Version spec field.
+var Versions = []string{"5.6", "5.7", "8.0"}
+
+// databaseNameFormat restricts restore database names to identifiers that are
+// safe to interpolate into the restore script.
+var databaseNameFormat = regexp.MustCompile(`^[0-9A-Za-z_]+$`)
+
+// timeZoneOffsetFormat matches UTC offset time zones like +02:00. Offsets
+// are the only form mysqld accepts without the time zone tables loaded.
+var timeZoneOffsetFormat = regexp.MustCompile(`^[+-](?:[01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// TimeZoneIsOffset returns true when the given time zone is a UTC offset
+// rather than a named zone.
+func TimeZoneIsOffset(zone string) bool {
+	return timeZoneOffsetFormat.MatchString(zone)
+}
+
+// DefaultImageForFlavor returns the default image of the given flavor's
+// image family.
+func DefaultImageForFlavor(flavor string) string {
+	switch flavor {
+	case FlavorMariaDB:
+		return "mariadb:latest"
+	case FlavorPercona:
+		return "percona:latest"
+	default:
+		return DefaultImage
+	}
+}
+
+// Ports the sidecar containers listen on, fixed in the pod template. With
+// host networking enabled they bind directly on the node, so the MySQL port
+// has to stay clear of them.
+const (
+	// SidecarClonePort is the port the xtrabackup sidecar serves clones on.
+	SidecarClonePort int32 = 3307
+	// SidecarMetricsPort is the port the metrics exporter listens on.
+	SidecarMetricsPort int32 = 9104
 )
 
+// SkipDefaultsAnnotation disables automatic defaulting of the cluster spec
+// when set to "true". The spec is then used verbatim, which means every
+// otherwise optional field has to be set explicitly.
+const SkipDefaultsAnnotation = "cr.mysqloperator.grtl.github.com/skip-defaults"
+
 // +genclient
 // +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -31,20 +154,415 @@ type MySQLClusterSpec struct {
 	Secret string `json:"secret"`
 	// Storage indicates the size of the Persistent Volume Claim for each replica.
 	Storage resource.Quantity `json:"storage"`
+	// StorageClassName selects the storage class of the data volume claims.
+	// Empty uses the cluster default class. The operator checks the class
+	// exists before creating resources, so a typo does not leave pods
+	// Pending.
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// AutoExpandStorage grows the data volume claims by a quarter when the
+	// storage pressure check crosses its threshold, provided the storage
+	// class allows volume expansion. Off by default.
+	AutoExpandStorage bool `json:"autoExpandStorage,omitempty"`
 	// Number of mysql instances in the cluster.
 	Replicas int32 `json:"replicas,omitempty"`
 	// Port specifies port for MySQL server.
 	Port int32 `json:"port,omitempty"`
 	// Image allows to specify mysql image
 	Image string `json:"image,omitempty"`
+	// Flavor selects the MySQL flavor the cluster runs - mysql, mariadb or
+	// percona. It picks the default image family and the matching probe
+	// commands. Defaults to mysql.
+	Flavor string `json:"flavor,omitempty"`
+	// Version pins the server version the default image is tagged with, e.g.
+	// 5.7 or 8.0. Empty keeps the flavor's latest tag. It is ignored when
+	// Image is set explicitly.
+	Version string `json:"version,omitempty"`
+	// TimeZone is the server time zone, either a zone name like
+	// Europe/Warsaw or a UTC offset like +02:00. Defaults to UTC, so
+	// timestamps do not depend on the node's zone.
+	TimeZone string `json:"timeZone,omitempty"`
+	// ConfigMapName references an existing config map whose .cnf files are
+	// loaded into the server configuration directory, e.g. to tune
+	// innodb_buffer_pool_size. It cannot be combined with Config.
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// Config is inline server configuration, keyed by file name. The operator
+	// materializes it as a config map owned by the cluster and loads it like
+	// ConfigMapName.
+	Config map[string]string `json:"config,omitempty"`
 	// FromBackup lets you specify the backup name to restore the cluster from.
 	FromBackup string `json:"fromBackup,omitempty"`
+	// RestoreOverwritePolicy controls what a restore does when the data
+	// volume already holds data - fail, overwrite or skipIfExists. Defaults
+	// to fail, so pre-existing data is never lost silently.
+	RestoreOverwritePolicy string `json:"restoreOverwritePolicy,omitempty"`
+	// RestoreDatabases limits the restore to the listed databases. System
+	// schemas are always restored. Empty restores the whole backup.
+	RestoreDatabases []string `json:"restoreDatabases,omitempty"`
+	// Metrics enables the exporter sidecar and configures how the cluster is
+	// scraped.
+	Metrics *MySQLClusterMetrics `json:"metrics,omitempty"`
+	// InitScript is a SQL script executed by the MySQL entrypoint on first
+	// boot. It cannot be combined with FromBackup.
+	InitScript *MySQLClusterInitScript `json:"initScript,omitempty"`
+	// MigrationGate runs a schema migration Job once the cluster members are
+	// up. Until the Job succeeds the cluster is not marked Ready and its
+	// pods are not attached to the read service.
+	MigrationGate *MySQLClusterMigrationGate `json:"migrationGate,omitempty"`
+	// ReadOnly forces the whole cluster read-only, including the primary.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// ReadServiceIncludesPrimary makes the read service route to the primary
+	// as well. The primary is always included when there are no replicas.
+	ReadServiceIncludesPrimary bool `json:"readServiceIncludesPrimary,omitempty"`
+	// ForceRestore skips the restore compatibility check for combinations
+	// known to be safe.
+	ForceRestore bool `json:"forceRestore,omitempty"`
+	// BootstrapTimeoutSeconds is how long a new cluster may take to become
+	// ready before it is marked BootstrapFailed. Zero disables the check.
+	BootstrapTimeoutSeconds int32 `json:"bootstrapTimeoutSeconds,omitempty"`
+	// RollbackOnBootstrapFailure deletes the created resources when the
+	// bootstrap times out. Off by default so data isn't destroyed on a slow
+	// restore bootstrap.
+	RollbackOnBootstrapFailure bool `json:"rollbackOnBootstrapFailure,omitempty"`
+	// FSGroupChangePolicy controls how Kubernetes adjusts volume ownership on
+	// mount. Defaults to OnRootMismatch to avoid a recursive chown of large
+	// data volumes.
+	FSGroupChangePolicy *string `json:"fsGroupChangePolicy,omitempty"`
+	// MinReadySeconds is how long a pod has to stay ready before a rolling
+	// update advances past it.
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+	// UpdateStrategy selects how template changes roll out - RollingUpdate
+	// or OnDelete. Empty keeps the StatefulSet default (RollingUpdate).
+	UpdateStrategy string `json:"updateStrategy,omitempty"`
+	// SchedulerName runs the cluster pods under a custom scheduler. Empty
+	// keeps the default scheduler.
+	SchedulerName string `json:"schedulerName,omitempty"`
+	// HostNetwork runs the cluster pods on the node's network, e.g. to
+	// integrate with an external VIP manager. The pod DNS policy is switched
+	// to ClusterFirstWithHostNet so service names keep resolving. Defaults
+	// to false.
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+	// DNSConfig tunes the pod DNS resolution. Unless set, the resolver
+	// option ndots:2 is applied, so connection-heavy clients resolve the
+	// service name with a single query instead of walking the search path.
+	// Set an empty object to opt out of the tuning.
+	DNSConfig *MySQLClusterDNSConfig `json:"dnsConfig,omitempty"`
+	// ReplicaStartupTimeoutSeconds is how long a replica waits for the
+	// primary to accept queries before its own mysqld starts. The barrier
+	// keeps replicas started in parallel from racing the primary bootstrap.
+	ReplicaStartupTimeoutSeconds int32 `json:"replicaStartupTimeoutSeconds,omitempty"`
+	// Proxy deploys a ProxySQL connection pooler in front of the cluster,
+	// splitting reads and writes at the proxy layer. Connection-heavy
+	// applications connect to the proxy service instead of the database
+	// services.
+	Proxy *MySQLClusterProxy `json:"proxy,omitempty"`
+	// AllowRecreate permits the operator to delete and recreate the stateful
+	// set (keeping the pods) when an immutable field has to change.
+	AllowRecreate bool `json:"allowRecreate,omitempty"`
+	// PVCRetentionPolicy controls what happens to the data volume claims
+	// when the cluster is deleted or scaled down. Both fields default to
+	// Retain.
+	PVCRetentionPolicy *MySQLClusterPVCRetentionPolicy `json:"pvcRetentionPolicy,omitempty"`
+	// SlowQueryLog enables the slow query log, streamed to stdout by a tail
+	// sidecar so log collectors pick it up.
+	SlowQueryLog *MySQLClusterSlowQueryLog `json:"slowQueryLog,omitempty"`
+	// FenceOnSplitBrain forces every writable member except the intended
+	// primary read-only when a split brain is detected. The intended primary
+	// is never fenced, so the cluster stays writable.
+	FenceOnSplitBrain bool `json:"fenceOnSplitBrain,omitempty"`
+	// PodLabels are extra descriptive labels, e.g. a team or cost center,
+	// merged onto the cluster pods. They are kept off the StatefulSet
+	// selector, which is immutable, so they can be added and changed freely.
+	// The app and role keys are reserved for the operator.
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+	// WriteServiceAnnotations are extra annotations set on the write
+	// service, e.g. cloud load balancer settings.
+	WriteServiceAnnotations map[string]string `json:"writeServiceAnnotations,omitempty"`
+	// ReadServiceAnnotations are extra annotations set on the read service.
+	ReadServiceAnnotations map[string]string `json:"readServiceAnnotations,omitempty"`
+	// LoadBalancerIP pins the write service to the given external IP, e.g.
+	// one from a MetalLB pool on bare metal. Setting it turns the write
+	// service into a LoadBalancer service.
+	LoadBalancerIP string `json:"loadBalancerIP,omitempty"`
+	// LoadBalancerClass selects the load balancer implementation for the
+	// write service when several are installed.
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+	// ConnectionDrainSeconds is how long a replica removed by a scale-down
+	// stays out of the read service before its pod is deleted, so open
+	// connections can finish. Zero disables draining.
+	ConnectionDrainSeconds int32 `json:"connectionDrainSeconds,omitempty"`
+	// LameDuckSeconds is how long a pod a rolling update is about to replace
+	// stays out of the read service before the update reaches it, so open
+	// connections can finish. The rollout is then driven pod by pod instead
+	// of handing the whole update to the StatefulSet at once. Zero disables
+	// lame-ducking.
+	LameDuckSeconds int32 `json:"lameDuckSeconds,omitempty"`
+	// DegradedGracePeriodSeconds is how long the cluster may stay unhealthy
+	// before its state flips to Degraded, so brief self-healing blips do
+	// not page anyone. Zero flips immediately.
+	DegradedGracePeriodSeconds int32 `json:"degradedGracePeriodSeconds,omitempty"`
+	// NamePrefix and NameSuffix decorate the names of the generated
+	// resources, e.g. for team naming conventions. The cluster labels and
+	// selectors keep using the plain cluster name.
+	NamePrefix string `json:"namePrefix,omitempty"`
+	NameSuffix string `json:"nameSuffix,omitempty"`
+	// ExtraManifests are additional Kubernetes objects the operator applies
+	// alongside the cluster - an escape hatch for resources the operator
+	// does not model, e.g. a VerticalPodAutoscaler. Each object is created
+	// with the cluster as its owner, so it is garbage collected with the
+	// cluster.
+	ExtraManifests []runtime.RawExtension `json:"extraManifests,omitempty"`
+	// BackupSchedules declares backup schedules inline on the cluster. The
+	// operator materializes each entry as a MySQLBackupSchedule object owned
+	// by the cluster, so the backup policy lives next to the cluster
+	// definition and is garbage collected with it.
+	BackupSchedules []InlineScheduleSpec `json:"backupSchedules,omitempty"`
+	// ExtraConfigMapMounts mounts additional config maps into the mysql
+	// container, e.g. a CA bundle or plugin configuration. The mount paths
+	// must stay clear of the operator-managed mounts.
+	ExtraConfigMapMounts []MySQLClusterExtraMount `json:"extraConfigMapMounts,omitempty"`
+	// ExtraSecretMounts mounts additional secrets into the mysql container.
+	ExtraSecretMounts []MySQLClusterExtraMount `json:"extraSecretMounts,omitempty"`
+	// ReadinessGates are extra pod readiness gates, e.g. a service mesh
+	// condition, so the pods are not marked ready before the mesh sidecar is
+	// up.
+	ReadinessGates []MySQLClusterReadinessGate `json:"readinessGates,omitempty"`
+}
+
+// MySQLClusterReadinessGate is an extra pod readiness gate. It mirrors the
+// pod spec readinessGates entry, which the vendored client libraries predate.
+type MySQLClusterReadinessGate struct {
+	// ConditionType is the pod condition the gate waits for.
+	ConditionType string `json:"conditionType"`
+}
+
+// InlineScheduleSpec declares a backup schedule inline on the cluster spec.
+// The embedded schedule spec is used as is, except that its Cluster field is
+// filled in by the operator.
+type InlineScheduleSpec struct {
+	// Name distinguishes the cluster's inline schedules. The materialized
+	// schedule object is named <cluster name>-<name>.
+	Name string `json:"name"`
+
+	MySQLBackupScheduleSpec `json:",inline"`
+}
+
+// MySQLClusterExtraMount mounts an existing config map or secret into the
+// mysql container.
+type MySQLClusterExtraMount struct {
+	// Name is the name of the config map or secret to mount.
+	Name string `json:"name"`
+	// MountPath is the absolute path the content is mounted at.
+	MountPath string `json:"mountPath"`
+}
+
+// reservedMountPaths are the mysql container mounts managed by the operator.
+// An extra mount shadowing one of them would break the server, so they are
+// rejected by validation.
+var reservedMountPaths = []string{
+	"/var/lib/mysql",
+	"/etc/mysql/conf.d",
+	"/docker-entrypoint-initdb.d",
+}
+
+// DefaultSlowQueryThresholdSeconds matches the MySQL long_query_time
+// default.
+const DefaultSlowQueryThresholdSeconds int32 = 10
+
+// MySQLClusterSlowQueryLog configures the slow query log.
+type MySQLClusterSlowQueryLog struct {
+	// ThresholdSeconds is the long_query_time threshold - queries taking
+	// longer are logged. Defaults to 10.
+	ThresholdSeconds int32 `json:"thresholdSeconds,omitempty"`
+}
+
+// PVC retention policy values.
+const (
+	PVCRetain = "Retain"
+	PVCDelete = "Delete"
+)
+
+// MySQLClusterPVCRetentionPolicy maps onto the stateful set
+// persistentVolumeClaimRetentionPolicy.
+type MySQLClusterPVCRetentionPolicy struct {
+	// WhenDeleted applies when the stateful set is deleted.
+	WhenDeleted string `json:"whenDeleted,omitempty"`
+	// WhenScaled applies when the cluster is scaled down.
+	WhenScaled string `json:"whenScaled,omitempty"`
+}
+
+// MySQLClusterInitScript points at a SQL script to run on first boot. Exactly
+// one of Inline and ConfigMap has to be set.
+type MySQLClusterInitScript struct {
+	// Inline is the script content itself.
+	Inline string `json:"inline,omitempty"`
+	// ConfigMap is the name of a config map holding the script.
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+// MySQLClusterProxy configures the ProxySQL connection pooler deployed in
+// front of the cluster.
+type MySQLClusterProxy struct {
+	// Replicas is the number of ProxySQL pods. Defaults to 1.
+	Replicas int32 `json:"replicas,omitempty"`
+	// Image overrides the ProxySQL image.
+	Image string `json:"image,omitempty"`
+}
+
+// MySQLClusterDNSConfig tunes the pod DNS resolution.
+type MySQLClusterDNSConfig struct {
+	// Options are resolver options, e.g. ndots.
+	Options []MySQLClusterDNSOption `json:"options,omitempty"`
+	// Searches are additional DNS search domains.
+	Searches []string `json:"searches,omitempty"`
+}
+
+// MySQLClusterDNSOption is a single resolver option.
+type MySQLClusterDNSOption struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// MySQLClusterMigrationGate describes the schema migration Job gating
+// cluster readiness.
+type MySQLClusterMigrationGate struct {
+	// Image is the image running the migration.
+	Image string `json:"image"`
+	// Command overrides the image entrypoint.
+	Command []string `json:"command,omitempty"`
+}
+
+// MySQLClusterMetrics configures the metrics exporter sidecar and the scrape
+// hints published on the metrics service.
+type MySQLClusterMetrics struct {
+	// ScrapeInterval advertises how often the metrics endpoint should be
+	// scraped.
+	ScrapeInterval string `json:"scrapeInterval,omitempty"`
+	// Labels are additional labels set on the metrics service, e.g. for
+	// relabeling rules.
+	Labels map[string]string `json:"labels,omitempty"`
+	// ServiceMonitor requests a prometheus-operator ServiceMonitor for the
+	// metrics service. It is skipped when the ServiceMonitor CRD is absent.
+	ServiceMonitor bool `json:"serviceMonitor,omitempty"`
+	// Resources are the exporter sidecar container resources. Small
+	// conservative requests are applied by default, so the exporter cannot
+	// compete with the database for resources.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // MySQLClusterStatus represents a cluster's status.
 type MySQLClusterStatus struct {
 	State   string `json:"state,omitempty"`
 	Message string `json:"message,omitempty"`
+	// RunningVersion is the MySQL server version reported by the primary.
+	RunningVersion string `json:"runningVersion,omitempty"`
+	// ReadOnly reports whether the primary currently refuses writes.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// UpdateOrder lists the pods in the order the current rolling update
+	// processes them.
+	UpdateOrder string `json:"updateOrder,omitempty"`
+	// DryRunDiff summarizes what the last dry-run reconcile would have
+	// changed.
+	DryRunDiff string `json:"dryRunDiff,omitempty"`
+	// BackupNowHandled is the last backup-now annotation value a backup was
+	// created for.
+	BackupNowHandled string `json:"backupNowHandled,omitempty"`
+	// LastBackupTime is when a backup of the cluster last completed
+	// successfully, so backup freshness can be alerted on straight off the
+	// cluster.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+	// SplitBrain reports that more than one member currently accepts writes.
+	SplitBrain bool `json:"splitBrain,omitempty"`
+	// RestorePolicy is the overwrite policy the last restore ran with.
+	RestorePolicy string `json:"restorePolicy,omitempty"`
+	// RestoredDatabases lists the databases the last restore was limited to.
+	// Empty means the whole backup was restored.
+	RestoredDatabases []string `json:"restoredDatabases,omitempty"`
+	// AuditLog holds the most recent reconcile actions the operator applied
+	// to the cluster, newest last.
+	AuditLog []MySQLClusterAuditEntry `json:"auditLog,omitempty"`
+	// Members breaks the cluster state down per member pod, ordered by
+	// ordinal.
+	Members []MySQLClusterMemberStatus `json:"members,omitempty"`
+	// Conditions reports individual aspects of the cluster state, such as
+	// whether the migration gate has passed.
+	Conditions []MySQLClusterCondition `json:"conditions,omitempty"`
+	// ResourceRecommendation is an advisory right-sizing hint computed from
+	// observed usage. The operator never applies it itself.
+	ResourceRecommendation *MySQLClusterResourceRecommendation `json:"resourceRecommendation,omitempty"`
+	// SchemaVersion is the schema marker the object was last written with.
+	// See the SchemaVersion constant.
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+	// UnhealthySince is when the cluster was first observed unhealthy. It
+	// is cleared by the next clean reconcile and drives the degraded grace
+	// period.
+	UnhealthySince *metav1.Time `json:"unhealthySince,omitempty"`
+}
+
+// ConditionMigrated reports whether the migration gate Job succeeded.
+const ConditionMigrated = "Migrated"
+
+// ConditionStoragePressure reports that a member's data volume usage crossed
+// the configured threshold.
+const ConditionStoragePressure = "StoragePressure"
+
+// ConditionZoneConflict reports that a member pod runs in a different zone
+// than its bound data volume, e.g. after a failover, which makes the pod
+// unschedulable on its next reschedule.
+const ConditionZoneConflict = "ZoneConflict"
+
+// MySQLClusterCondition reports one aspect of the cluster state.
+type MySQLClusterCondition struct {
+	// Type identifies the condition, e.g. Migrated.
+	Type string `json:"type"`
+	// Status is True, False or Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Message explains the status in human terms.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is when Status last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// MySQLClusterResourceRecommendation suggests per-pod resource requests
+// derived from the peak usage observed across the cluster members, plus
+// headroom. It is advisory only - applying it is left to the user, so the
+// recommendation does not fight a VerticalPodAutoscaler.
+type MySQLClusterResourceRecommendation struct {
+	// CPU and Memory are the suggested per-pod resource requests.
+	CPU    resource.Quantity `json:"cpu"`
+	Memory resource.Quantity `json:"memory"`
+	// ObservedAt is when the usage behind the recommendation was last
+	// sampled.
+	ObservedAt metav1.Time `json:"observedAt,omitempty"`
+}
+
+// MySQLClusterMemberStatus describes a single cluster member pod.
+type MySQLClusterMemberStatus struct {
+	// Name is the pod name.
+	Name string `json:"name"`
+	// Ordinal is the member's stateful set ordinal.
+	Ordinal int32 `json:"ordinal"`
+	// Role is the member's replication role - primary or replica.
+	Role string `json:"role,omitempty"`
+	// Ready reports whether the pod passes its readiness probe.
+	Ready bool `json:"ready"`
+	// ReplicationLag is how many seconds the member lags behind the
+	// primary; -1 when unknown.
+	ReplicationLag int64 `json:"replicationLag,omitempty"`
+}
+
+// MaxAuditLogEntries is how many reconcile actions the status audit log
+// keeps; older entries are dropped.
+const MaxAuditLogEntries = 20
+
+// MySQLClusterAuditEntry records a single reconcile action the operator
+// applied to the cluster.
+type MySQLClusterAuditEntry struct {
+	// Time is when the action was applied.
+	Time metav1.Time `json:"time"`
+	// Action names the applied action, e.g. Create, Update or Delete.
+	Action string `json:"action"`
+	// Summary describes what changed, e.g. the before/after values.
+	Summary string `json:"summary,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -57,8 +575,84 @@ type MySQLClusterList struct {
 	Items []MySQLCluster `json:"items"`
 }
 
+// knownFlavor reports whether the given flavor is one the operator knows.
+func knownFlavor(flavor string) bool {
+	for _, known := range Flavors {
+		if flavor == known {
+			return true
+		}
+	}
+	return false
+}
+
+// knownVersion reports whether the given version is one the default images
+// are published for.
+func knownVersion(version string) bool {
+	for _, known := range Versions {
+		if version == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceName returns the base name the cluster's resources derive from -
+// the cluster name with the optional spec name prefix and suffix applied.
+func (c *MySQLCluster) ResourceName() string {
+	return c.Spec.NamePrefix + c.Name + c.Spec.NameSuffix
+}
+
+// HostNetworkEnabled reports whether the cluster pods run on the node's
+// network.
+func (c *MySQLCluster) HostNetworkEnabled() bool {
+	return c.Spec.HostNetwork != nil && *c.Spec.HostNetwork
+}
+
+// Condition returns the status condition of the given type, or nil when the
+// cluster does not carry it.
+func (c *MySQLCluster) Condition(conditionType string) *MySQLClusterCondition {
+	for i := range c.Status.Conditions {
+		if c.Status.Conditions[i].Type == conditionType {
+			return &c.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition records the status condition, bumping the transition time
+// only when the status actually changes.
+func (c *MySQLCluster) SetCondition(conditionType string, status corev1.ConditionStatus, message string) {
+	condition := c.Condition(conditionType)
+	if condition == nil {
+		c.Status.Conditions = append(c.Status.Conditions, MySQLClusterCondition{
+			Type:               conditionType,
+			Status:             status,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		return
+	}
+
+	if condition.Status != status {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	condition.Status = status
+	condition.Message = message
+}
+
+// SkipDefaults returns true when the cluster opted out of automatic
+// defaulting via the SkipDefaultsAnnotation.
+func (c *MySQLCluster) SkipDefaults() bool {
+	return c.Annotations[SkipDefaultsAnnotation] == "true"
+}
+
 // WithDefaults fills cluster missing fields with their default values.
+// It is a no-op for clusters which opted out via the SkipDefaultsAnnotation.
 func (c *MySQLCluster) WithDefaults() {
+	if c.SkipDefaults() {
+		return
+	}
+
 	if c.Spec.Replicas == 0 {
 		c.Spec.Replicas = DefaultReplicas
 	}
@@ -67,7 +661,388 @@ func (c *MySQLCluster) WithDefaults() {
 		c.Spec.Port = DefaultPort
 	}
 
+	if c.Spec.Flavor == "" {
+		c.Spec.Flavor = DefaultFlavor
+	}
+
+	if c.Spec.TimeZone == "" {
+		c.Spec.TimeZone = DefaultTimeZone
+	}
+
+	// Normalize the backup reference, so stray whitespace from a copy-paste
+	// does not turn into a confusing "not found" error.
+	c.Spec.FromBackup = strings.TrimSpace(c.Spec.FromBackup)
+
+	if c.Spec.FromBackup != "" && c.Spec.RestoreOverwritePolicy == "" {
+		c.Spec.RestoreOverwritePolicy = RestoreFail
+	}
+
 	if c.Spec.Image == "" {
-		c.Spec.Image = DefaultImage
+		image := DefaultImageForFlavor(c.Spec.Flavor)
+		if c.Spec.Version != "" {
+			image = strings.TrimSuffix(image, ":latest") + ":" + c.Spec.Version
+		}
+		c.Spec.Image = image
+	}
+
+	if c.Spec.Metrics != nil && c.Spec.Metrics.ScrapeInterval == "" {
+		c.Spec.Metrics.ScrapeInterval = DefaultMetricsScrapeInterval
+	}
+
+	if c.Spec.MinReadySeconds == 0 {
+		c.Spec.MinReadySeconds = DefaultMinReadySeconds
+	}
+
+	if c.Spec.FSGroupChangePolicy == nil {
+		policy := DefaultFSGroupChangePolicy
+		c.Spec.FSGroupChangePolicy = &policy
+	}
+
+	if c.Spec.HostNetwork == nil {
+		hostNetwork := false
+		c.Spec.HostNetwork = &hostNetwork
+	}
+
+	if c.Spec.DNSConfig == nil {
+		c.Spec.DNSConfig = &MySQLClusterDNSConfig{
+			Options: []MySQLClusterDNSOption{{Name: "ndots", Value: DefaultDNSNdots}},
+		}
+	}
+
+	if c.Spec.ReplicaStartupTimeoutSeconds == 0 {
+		c.Spec.ReplicaStartupTimeoutSeconds = DefaultReplicaStartupTimeoutSeconds
+	}
+
+	if c.Spec.Proxy != nil {
+		if c.Spec.Proxy.Replicas == 0 {
+			c.Spec.Proxy.Replicas = DefaultProxyReplicas
+		}
+		if c.Spec.Proxy.Image == "" {
+			c.Spec.Proxy.Image = DefaultProxyImage
+		}
+	}
+
+	if c.Spec.PVCRetentionPolicy == nil {
+		c.Spec.PVCRetentionPolicy = new(MySQLClusterPVCRetentionPolicy)
+	}
+	if c.Spec.PVCRetentionPolicy.WhenDeleted == "" {
+		c.Spec.PVCRetentionPolicy.WhenDeleted = PVCRetain
+	}
+	if c.Spec.PVCRetentionPolicy.WhenScaled == "" {
+		c.Spec.PVCRetentionPolicy.WhenScaled = PVCRetain
+	}
+
+	if c.Spec.SlowQueryLog != nil && c.Spec.SlowQueryLog.ThresholdSeconds == 0 {
+		c.Spec.SlowQueryLog.ThresholdSeconds = DefaultSlowQueryThresholdSeconds
+	}
+
+	if c.Spec.Storage.IsZero() {
+		c.Spec.Storage = resource.MustParse(DefaultStorage)
+	}
+
+	// Normalize the quantity so repeated reconciles compare equal no matter
+	// which format the size was given in (e.g. 10Gi vs 10737418240).
+	c.Spec.Storage = *resource.NewQuantity(c.Spec.Storage.Value(), resource.BinarySI)
+}
+
+// Validate checks that the cluster spec is complete enough to reconcile.
+func (c *MySQLCluster) Validate() error {
+	if c.Spec.Secret == "" {
+		return fmt.Errorf("cluster %q must specify a secret", c.Name)
+	}
+
+	if c.Spec.Storage.IsZero() {
+		return fmt.Errorf("cluster %q must specify a storage size", c.Name)
+	}
+
+	if c.Spec.InitScript != nil {
+		if c.Spec.FromBackup != "" {
+			return fmt.Errorf(
+				"cluster %q cannot combine an init script with a backup restore", c.Name)
+		}
+		if (c.Spec.InitScript.Inline == "") == (c.Spec.InitScript.ConfigMap == "") {
+			return fmt.Errorf(
+				"cluster %q must specify exactly one of inline init script and config map", c.Name)
+		}
+	}
+
+	if c.Spec.ConfigMapName != "" && len(c.Spec.Config) > 0 {
+		return fmt.Errorf(
+			"cluster %q cannot combine a config map reference with inline config", c.Name)
+	}
+
+	if c.Spec.PVCRetentionPolicy != nil {
+		for _, policy := range []string{
+			c.Spec.PVCRetentionPolicy.WhenDeleted,
+			c.Spec.PVCRetentionPolicy.WhenScaled,
+		} {
+			if policy != "" && policy != PVCRetain && policy != PVCDelete {
+				return fmt.Errorf(
+					"cluster %q has invalid PVC retention policy %q", c.Name, policy)
+			}
+		}
+	}
+
+	if c.Spec.SlowQueryLog != nil && c.Spec.SlowQueryLog.ThresholdSeconds < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative slow query threshold", c.Name)
+	}
+
+	if c.Spec.FromBackup != "" {
+		if strings.Contains(c.Spec.FromBackup, "/") {
+			return fmt.Errorf(
+				"cluster %q references backup %q: cross-namespace restores are not supported, "+
+					"use the bare backup name from the cluster's own namespace",
+				c.Name, c.Spec.FromBackup)
+		}
+		if errs := validation.IsDNS1123Subdomain(c.Spec.FromBackup); len(errs) > 0 {
+			return fmt.Errorf(
+				"cluster %q has an invalid backup reference %q: %s",
+				c.Name, c.Spec.FromBackup, strings.Join(errs, ", "))
+		}
+	}
+
+	switch c.Spec.RestoreOverwritePolicy {
+	case "", RestoreFail, RestoreOverwrite, RestoreSkipIfExists:
+	default:
+		return fmt.Errorf(
+			"cluster %q has an unknown restore overwrite policy %q, known policies are: %s, %s, %s",
+			c.Name, c.Spec.RestoreOverwritePolicy,
+			RestoreFail, RestoreOverwrite, RestoreSkipIfExists)
 	}
+
+	if len(c.Spec.RestoreDatabases) > 0 {
+		if c.Spec.FromBackup == "" {
+			return fmt.Errorf(
+				"cluster %q specifies restore databases without a backup to restore from", c.Name)
+		}
+		for _, database := range c.Spec.RestoreDatabases {
+			if !databaseNameFormat.MatchString(database) {
+				return fmt.Errorf(
+					"cluster %q has an invalid restore database name %q", c.Name, database)
+			}
+		}
+	}
+
+	switch c.Spec.UpdateStrategy {
+	case "", UpdateRollingUpdate, UpdateOnDelete:
+	default:
+		return fmt.Errorf(
+			"cluster %q has an unknown update strategy %q, known strategies are: %s, %s",
+			c.Name, c.Spec.UpdateStrategy, UpdateRollingUpdate, UpdateOnDelete)
+	}
+
+	if c.Spec.Flavor != "" && !knownFlavor(c.Spec.Flavor) {
+		return fmt.Errorf(
+			"cluster %q has an unknown flavor %q, known flavors are: %s",
+			c.Name, c.Spec.Flavor, strings.Join(Flavors, ", "))
+	}
+
+	if c.Spec.Version != "" && !knownVersion(c.Spec.Version) {
+		return fmt.Errorf(
+			"cluster %q has an unknown version %q, known versions are: %s",
+			c.Name, c.Spec.Version, strings.Join(Versions, ", "))
+	}
+
+	if c.Spec.ConnectionDrainSeconds < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative connection drain period", c.Name)
+	}
+
+	if c.Spec.LameDuckSeconds < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative lame-duck period", c.Name)
+	}
+
+	if c.Spec.DegradedGracePeriodSeconds < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative degraded grace period", c.Name)
+	}
+
+	if c.Spec.ReplicaStartupTimeoutSeconds < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative replica startup timeout", c.Name)
+	}
+
+	if c.Spec.Proxy != nil && c.Spec.Proxy.Replicas < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative proxy replica count", c.Name)
+	}
+
+	if c.HostNetworkEnabled() {
+		// On the host network the container ports bind directly on the node.
+		// Privileged ports would require extra capabilities, and the sidecar
+		// ports are fixed in the pod template.
+		if c.Spec.Port != 0 && c.Spec.Port < 1024 {
+			return fmt.Errorf(
+				"cluster %q uses host networking with the privileged port %d",
+				c.Name, c.Spec.Port)
+		}
+		if c.Spec.Port == SidecarClonePort || c.Spec.Port == SidecarMetricsPort {
+			return fmt.Errorf(
+				"cluster %q uses host networking with port %d, which collides with a sidecar port",
+				c.Name, c.Spec.Port)
+		}
+	}
+
+	if c.Spec.TimeZone != "" && !TimeZoneIsOffset(c.Spec.TimeZone) {
+		if _, err := time.LoadLocation(c.Spec.TimeZone); err != nil {
+			return fmt.Errorf(
+				"cluster %q has an unknown time zone %q", c.Name, c.Spec.TimeZone)
+		}
+	}
+
+	if c.Spec.LoadBalancerIP != "" && net.ParseIP(c.Spec.LoadBalancerIP) == nil {
+		return fmt.Errorf(
+			"cluster %q has an invalid load balancer IP %q", c.Name, c.Spec.LoadBalancerIP)
+	}
+
+	for key, value := range c.Spec.PodLabels {
+		// The selector and role labels are the operator's - a pod label
+		// shadowing them would break the StatefulSet selector or the role
+		// routing.
+		if key == "app" || key == "role" {
+			return fmt.Errorf(
+				"cluster %q pod label %q collides with a selector label", c.Name, key)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf(
+				"cluster %q has an invalid pod label key %q", c.Name, key)
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf(
+				"cluster %q has an invalid pod label value %q", c.Name, value)
+		}
+	}
+
+	mountPaths := map[string]bool{}
+	for _, mount := range append(append([]MySQLClusterExtraMount{},
+		c.Spec.ExtraConfigMapMounts...), c.Spec.ExtraSecretMounts...) {
+		if mount.Name == "" || mount.MountPath == "" {
+			return fmt.Errorf(
+				"cluster %q has an extra mount without a name or mount path", c.Name)
+		}
+		mountPath := path.Clean(mount.MountPath)
+		if !path.IsAbs(mountPath) {
+			return fmt.Errorf(
+				"cluster %q extra mount %q has a relative mount path %q",
+				c.Name, mount.Name, mount.MountPath)
+		}
+		for _, reserved := range reservedMountPaths {
+			if mountPath == reserved {
+				return fmt.Errorf(
+					"cluster %q extra mount %q collides with the operator-managed mount %q",
+					c.Name, mount.Name, reserved)
+			}
+		}
+		if mountPaths[mountPath] {
+			return fmt.Errorf(
+				"cluster %q mounts two extra sources at %q", c.Name, mountPath)
+		}
+		mountPaths[mountPath] = true
+	}
+
+	for _, gate := range c.Spec.ReadinessGates {
+		if gate.ConditionType == "" {
+			return fmt.Errorf(
+				"cluster %q has a readiness gate without a condition type", c.Name)
+		}
+	}
+
+	if c.Spec.MigrationGate != nil && c.Spec.MigrationGate.Image == "" {
+		return fmt.Errorf(
+			"cluster %q must specify a migration gate image", c.Name)
+	}
+
+	if c.Spec.SchedulerName != "" {
+		if errs := validation.IsDNS1123Subdomain(c.Spec.SchedulerName); len(errs) > 0 {
+			return fmt.Errorf(
+				"cluster %q has an invalid scheduler name %q: %s",
+				c.Name, c.Spec.SchedulerName, errs[0])
+		}
+	}
+
+	for i, manifest := range c.Spec.ExtraManifests {
+		// Decode with plain json - unstructured.UnmarshalJSON reports a
+		// missing kind as a parse error rather than leaving it empty.
+		content := map[string]interface{}{}
+		if err := json.Unmarshal(manifest.Raw, &content); err != nil {
+			return fmt.Errorf(
+				"cluster %q has an unparsable extra manifest at index %d: %v",
+				c.Name, i, err)
+		}
+		object := &unstructured.Unstructured{Object: content}
+		if object.GetAPIVersion() == "" || object.GetKind() == "" {
+			return fmt.Errorf(
+				"cluster %q has an extra manifest without apiVersion or kind at index %d",
+				c.Name, i)
+		}
+	}
+
+	scheduleNames := map[string]bool{}
+	for _, schedule := range c.Spec.BackupSchedules {
+		if schedule.Name == "" {
+			return fmt.Errorf(
+				"cluster %q has an inline backup schedule without a name", c.Name)
+		}
+		if errs := validation.IsDNS1123Label(schedule.Name); len(errs) > 0 {
+			return fmt.Errorf(
+				"cluster %q has an invalid inline backup schedule name %q: %s",
+				c.Name, schedule.Name, errs[0])
+		}
+		if scheduleNames[schedule.Name] {
+			return fmt.Errorf(
+				"cluster %q declares the inline backup schedule %q twice",
+				c.Name, schedule.Name)
+		}
+		scheduleNames[schedule.Name] = true
+		if err := ValidateCronExpression(schedule.Time); err != nil {
+			return fmt.Errorf(
+				"cluster %q inline backup schedule %q: %v", c.Name, schedule.Name, err)
+		}
+	}
+
+	if c.Spec.DNSConfig != nil {
+		for _, option := range c.Spec.DNSConfig.Options {
+			if option.Name == "" {
+				return fmt.Errorf(
+					"cluster %q specifies a DNS option without a name", c.Name)
+			}
+		}
+		for _, search := range c.Spec.DNSConfig.Searches {
+			if errs := validation.IsDNS1123Subdomain(search); len(errs) > 0 {
+				return fmt.Errorf(
+					"cluster %q has an invalid DNS search domain %q: %s",
+					c.Name, search, errs[0])
+			}
+		}
+	}
+
+	resourceName := c.ResourceName()
+	if errs := validation.IsDNS1123Label(resourceName); len(errs) > 0 {
+		return fmt.Errorf(
+			"cluster %q resource name %q is not a valid DNS label: %s",
+			c.Name, resourceName, errs[0])
+	}
+	// The longest derived name still has to fit the DNS label limit.
+	if len(resourceName)+len("-proxy-config") > validation.DNS1123LabelMaxLength {
+		return fmt.Errorf(
+			"cluster %q resource name %q leaves no room for the derived name suffixes",
+			c.Name, resourceName)
+	}
+
+	// With defaulting disabled every optional field has to be set explicitly.
+	if c.SkipDefaults() {
+		if c.Spec.Replicas == 0 {
+			return fmt.Errorf("cluster %q skips defaults and must specify replicas", c.Name)
+		}
+		if c.Spec.Port == 0 {
+			return fmt.Errorf("cluster %q skips defaults and must specify a port", c.Name)
+		}
+		if c.Spec.Image == "" {
+			return fmt.Errorf("cluster %q skips defaults and must specify an image", c.Name)
+		}
+	}
+
+	return nil
 }
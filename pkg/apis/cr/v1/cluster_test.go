@@ -0,0 +1,465 @@
+package v1_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Cluster", func() {
+	var cluster *crv1.MySQLCluster
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+	})
+
+	Describe("WithDefaults", func() {
+		It("defaults the PVC retention policy to Retain", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.PVCRetentionPolicy).NotTo(BeNil())
+			Expect(cluster.Spec.PVCRetentionPolicy.WhenDeleted).To(Equal(crv1.PVCRetain))
+			Expect(cluster.Spec.PVCRetentionPolicy.WhenScaled).To(Equal(crv1.PVCRetain))
+		})
+
+		It("defaults minReadySeconds", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.MinReadySeconds).To(Equal(crv1.DefaultMinReadySeconds))
+		})
+
+		It("defaults the storage size", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.Storage.String()).To(Equal(crv1.DefaultStorage))
+		})
+
+		It("normalizes an equivalent storage quantity to the same value", func() {
+			cluster.Spec.Storage = resource.MustParse("10737418240")
+
+			other := new(crv1.MySQLCluster)
+			other.Spec.Storage = resource.MustParse("10Gi")
+
+			cluster.WithDefaults()
+			other.WithDefaults()
+			Expect(cluster.Spec.Storage.String()).To(Equal(other.Spec.Storage.String()))
+		})
+
+		It("defaults the slow query threshold when enabled", func() {
+			cluster.Spec.SlowQueryLog = new(crv1.MySQLClusterSlowQueryLog)
+			cluster.WithDefaults()
+			Expect(cluster.Spec.SlowQueryLog.ThresholdSeconds).To(
+				Equal(crv1.DefaultSlowQueryThresholdSeconds))
+		})
+
+		It("defaults the image from the flavor", func() {
+			cluster.Spec.Flavor = crv1.FlavorMariaDB
+			cluster.WithDefaults()
+			Expect(cluster.Spec.Image).To(Equal("mariadb:latest"))
+		})
+
+		It("pins the default image to the requested version", func() {
+			cluster.Spec.Version = "8.0"
+			cluster.WithDefaults()
+			Expect(cluster.Spec.Image).To(Equal("mysql:8.0"))
+		})
+
+		It("keeps an explicit image over the version", func() {
+			cluster.Spec.Image = "registry.local/mysql:custom"
+			cluster.Spec.Version = "8.0"
+			cluster.WithDefaults()
+			Expect(cluster.Spec.Image).To(Equal("registry.local/mysql:custom"))
+		})
+
+		It("defaults the time zone to UTC", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.TimeZone).To(Equal(crv1.DefaultTimeZone))
+		})
+
+		It("defaults host networking to off", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.HostNetwork).NotTo(BeNil())
+			Expect(*cluster.Spec.HostNetwork).To(BeFalse())
+		})
+
+		It("defaults the restore overwrite policy for restores", func() {
+			cluster.Spec.FromBackup = "my-backup"
+			cluster.WithDefaults()
+			Expect(cluster.Spec.RestoreOverwritePolicy).To(Equal(crv1.RestoreFail))
+		})
+
+		It("trims whitespace off the backup reference", func() {
+			cluster.Spec.FromBackup = " my-backup\n"
+			cluster.WithDefaults()
+			Expect(cluster.Spec.FromBackup).To(Equal("my-backup"))
+		})
+
+		It("defaults the DNS config to ndots tuning", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.DNSConfig).NotTo(BeNil())
+			Expect(cluster.Spec.DNSConfig.Options).To(Equal([]crv1.MySQLClusterDNSOption{
+				{Name: "ndots", Value: crv1.DefaultDNSNdots},
+			}))
+		})
+
+		It("leaves an explicit empty DNS config alone", func() {
+			cluster.Spec.DNSConfig = new(crv1.MySQLClusterDNSConfig)
+			cluster.WithDefaults()
+			Expect(cluster.Spec.DNSConfig.Options).To(BeEmpty())
+		})
+
+		It("defaults the replica startup timeout", func() {
+			cluster.WithDefaults()
+			Expect(cluster.Spec.ReplicaStartupTimeoutSeconds).To(
+				Equal(crv1.DefaultReplicaStartupTimeoutSeconds))
+		})
+
+		It("sizes an enabled proxy", func() {
+			cluster.Spec.Proxy = new(crv1.MySQLClusterProxy)
+			cluster.WithDefaults()
+			Expect(cluster.Spec.Proxy.Replicas).To(Equal(crv1.DefaultProxyReplicas))
+			Expect(cluster.Spec.Proxy.Image).To(Equal(crv1.DefaultProxyImage))
+		})
+
+		It("is stable across repeated defaulting", func() {
+			cluster.Spec.Storage = resource.MustParse("10Gi")
+			cluster.WithDefaults()
+			normalized := cluster.Spec.Storage.String()
+
+			cluster.WithDefaults()
+			Expect(cluster.Spec.Storage.String()).To(Equal(normalized))
+		})
+	})
+
+	Describe("Validate", func() {
+		BeforeEach(func() {
+			cluster.Name = "my-cluster"
+			cluster.Spec.Secret = "my-secret"
+			cluster.Spec.Storage = resource.MustParse("1Gi")
+		})
+
+		It("rejects an invalid PVC retention policy", func() {
+			cluster.Spec.PVCRetentionPolicy = &crv1.MySQLClusterPVCRetentionPolicy{
+				WhenDeleted: "Recycle",
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("PVC retention policy"))
+		})
+
+		It("rejects a negative slow query threshold", func() {
+			cluster.Spec.SlowQueryLog = &crv1.MySQLClusterSlowQueryLog{
+				ThresholdSeconds: -1,
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("slow query threshold"))
+		})
+
+		It("rejects a cross-namespace backup reference", func() {
+			cluster.Spec.FromBackup = "other-namespace/my-backup"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cross-namespace"))
+		})
+
+		It("rejects an invalid backup reference", func() {
+			cluster.Spec.FromBackup = "My Backup"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("backup reference"))
+		})
+
+		It("rejects a readiness gate without a condition type", func() {
+			cluster.Spec.ReadinessGates = []crv1.MySQLClusterReadinessGate{
+				{ConditionType: ""},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("readiness gate"))
+		})
+
+		It("rejects combining a config map reference with inline config", func() {
+			cluster.Spec.ConfigMapName = "tuned-mysql"
+			cluster.Spec.Config = map[string]string{"tuning.cnf": "[mysqld]"}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("inline config"))
+		})
+
+		It("rejects an extra mount shadowing an operator-managed mount", func() {
+			cluster.Spec.ExtraConfigMapMounts = []crv1.MySQLClusterExtraMount{
+				{Name: "rogue-config", MountPath: "/etc/mysql/conf.d/"},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("operator-managed mount"))
+		})
+
+		It("rejects two extra mounts sharing a mount path", func() {
+			cluster.Spec.ExtraConfigMapMounts = []crv1.MySQLClusterExtraMount{
+				{Name: "ca-bundle", MountPath: "/etc/mysql/ca"},
+			}
+			cluster.Spec.ExtraSecretMounts = []crv1.MySQLClusterExtraMount{
+				{Name: "client-certs", MountPath: "/etc/mysql/ca"},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("two extra sources"))
+		})
+
+		It("accepts an extra mount on a free path", func() {
+			cluster.Spec.ExtraSecretMounts = []crv1.MySQLClusterExtraMount{
+				{Name: "client-certs", MountPath: "/etc/mysql/certs"},
+			}
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("rejects an unknown restore overwrite policy", func() {
+			cluster.Spec.RestoreOverwritePolicy = "merge"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("restore overwrite policy"))
+		})
+
+		It("rejects an invalid scheduler name", func() {
+			cluster.Spec.SchedulerName = "Gang Scheduler"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("scheduler name"))
+		})
+
+		It("rejects a negative proxy replica count", func() {
+			cluster.Spec.Proxy = &crv1.MySQLClusterProxy{Replicas: -1}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("proxy replica count"))
+		})
+
+		It("rejects a negative replica startup timeout", func() {
+			cluster.Spec.ReplicaStartupTimeoutSeconds = -1
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("replica startup timeout"))
+		})
+
+		It("accepts a parsable extra manifest", func() {
+			cluster.Spec.ExtraManifests = []runtime.RawExtension{
+				{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"extra"}}`)},
+			}
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("rejects an unparsable extra manifest", func() {
+			cluster.Spec.ExtraManifests = []runtime.RawExtension{
+				{Raw: []byte(`not json`)},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unparsable extra manifest"))
+		})
+
+		It("rejects an extra manifest without a kind", func() {
+			cluster.Spec.ExtraManifests = []runtime.RawExtension{
+				{Raw: []byte(`{"apiVersion":"v1","metadata":{"name":"extra"}}`)},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("without apiVersion or kind"))
+		})
+
+		It("rejects a DNS option without a name", func() {
+			cluster.Spec.DNSConfig = &crv1.MySQLClusterDNSConfig{
+				Options: []crv1.MySQLClusterDNSOption{{Value: "2"}},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("DNS option"))
+		})
+
+		It("rejects an invalid DNS search domain", func() {
+			cluster.Spec.DNSConfig = &crv1.MySQLClusterDNSConfig{
+				Searches: []string{"not a domain"},
+			}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("DNS search domain"))
+		})
+
+		It("rejects a migration gate without an image", func() {
+			cluster.Spec.MigrationGate = &crv1.MySQLClusterMigrationGate{}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("migration gate image"))
+		})
+
+		It("rejects restore databases without a backup", func() {
+			cluster.Spec.RestoreDatabases = []string{"shop"}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("without a backup"))
+		})
+
+		It("rejects an invalid restore database name", func() {
+			cluster.Spec.FromBackup = "my-backup"
+			cluster.Spec.RestoreDatabases = []string{"shop", "shop; drop"}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("restore database name"))
+		})
+
+		It("rejects an unknown update strategy", func() {
+			cluster.Spec.UpdateStrategy = "Recreate"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown update strategy"))
+		})
+
+		It("accepts the OnDelete update strategy", func() {
+			cluster.Spec.UpdateStrategy = crv1.UpdateOnDelete
+			err := cluster.Validate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects an unknown flavor", func() {
+			cluster.Spec.Flavor = "oracle"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown flavor"))
+		})
+
+		It("rejects an unknown version", func() {
+			cluster.Spec.Version = "4.1"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown version"))
+		})
+
+		It("accepts host networking with an unprivileged port", func() {
+			hostNetwork := true
+			cluster.Spec.HostNetwork = &hostNetwork
+			cluster.Spec.Port = 3306
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("rejects host networking with a privileged port", func() {
+			hostNetwork := true
+			cluster.Spec.HostNetwork = &hostNetwork
+			cluster.Spec.Port = 306
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("privileged port"))
+		})
+
+		It("rejects host networking with a sidecar port collision", func() {
+			hostNetwork := true
+			cluster.Spec.HostNetwork = &hostNetwork
+			cluster.Spec.Port = crv1.SidecarMetricsPort
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("collides with a sidecar port"))
+		})
+
+		It("accepts a UTC offset time zone", func() {
+			cluster.Spec.TimeZone = "-05:30"
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("accepts a named time zone", func() {
+			cluster.Spec.TimeZone = "Europe/Warsaw"
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("rejects an unknown time zone", func() {
+			cluster.Spec.TimeZone = "Mars/Olympus_Mons"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown time zone"))
+		})
+
+		It("accepts a valid load balancer IP", func() {
+			cluster.Spec.LoadBalancerIP = "192.0.2.10"
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("rejects an invalid load balancer IP", func() {
+			cluster.Spec.LoadBalancerIP = "not-an-ip"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("load balancer IP"))
+		})
+
+		It("accepts descriptive pod labels", func() {
+			cluster.Spec.PodLabels = map[string]string{"team": "payments"}
+			Expect(cluster.Validate()).To(Succeed())
+		})
+
+		It("rejects a pod label colliding with a selector label", func() {
+			cluster.Spec.PodLabels = map[string]string{"app": "other"}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("collides with a selector label"))
+		})
+
+		It("rejects an invalid pod label value", func() {
+			cluster.Spec.PodLabels = map[string]string{"team": "no spaces allowed"}
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid pod label value"))
+		})
+
+		It("rejects a name prefix producing an invalid DNS label", func() {
+			cluster.Name = "my-cluster"
+			cluster.Spec.NamePrefix = "Prod-"
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("DNS label"))
+		})
+
+		It("rejects a resource name leaving no room for derived names", func() {
+			cluster.Name = "my-cluster"
+			cluster.Spec.NameSuffix = "-" + strings.Repeat("x", 49)
+			err := cluster.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("derived name"))
+		})
+	})
+
+	Describe("SetCondition", func() {
+		It("adds a new condition", func() {
+			cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionFalse, "running")
+
+			condition := cluster.Condition(crv1.ConditionMigrated)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Message).To(Equal("running"))
+		})
+
+		It("bumps the transition time only on a status change", func() {
+			cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionFalse, "running")
+			recorded := cluster.Condition(crv1.ConditionMigrated).LastTransitionTime
+
+			cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionFalse, "still running")
+			Expect(cluster.Condition(crv1.ConditionMigrated).LastTransitionTime).To(Equal(recorded))
+			Expect(cluster.Status.Conditions).To(HaveLen(1))
+		})
+	})
+
+	Describe("ResourceName", func() {
+		It("defaults to the cluster name", func() {
+			cluster.Name = "my-cluster"
+			Expect(cluster.ResourceName()).To(Equal("my-cluster"))
+		})
+
+		It("applies the name prefix and suffix", func() {
+			cluster.Name = "my-cluster"
+			cluster.Spec.NamePrefix = "prod-"
+			cluster.Spec.NameSuffix = "-eu"
+			Expect(cluster.ResourceName()).To(Equal("prod-my-cluster-eu"))
+		})
+	})
+})
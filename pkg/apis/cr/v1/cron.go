@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronField describes the allowed values of one cron expression field.
+type cronField struct {
+	name     string
+	min, max int
+	// names maps the symbolic values the field accepts, e.g. month names, to
+	// their numbers.
+	names map[string]int
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+
+	cronDayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+
+	// cronStandardFields are the five standard cron fields - the only layout
+	// the CronJob controller accepts.
+	cronStandardFields = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: cronMonthNames},
+		// Both 0 and 7 mean Sunday.
+		{name: "day of week", min: 0, max: 7, names: cronDayNames},
+	}
+
+	// cronDescriptors are the shorthand expressions the CronJob controller
+	// understands.
+	cronDescriptors = map[string]bool{
+		"@yearly":   true,
+		"@annually": true,
+		"@monthly":  true,
+		"@weekly":   true,
+		"@daily":    true,
+		"@midnight": true,
+		"@hourly":   true,
+	}
+)
+
+// ValidateCronExpression checks a standard 5-field cron expression and names
+// the offending field in the error. The CronJob controller accepts exactly
+// five fields - a malformed expression caught here would otherwise
+// materialize as a schedule that silently never fires.
+func ValidateCronExpression(expression string) error {
+	trimmed := strings.TrimSpace(expression)
+	if cronDescriptors[trimmed] {
+		return nil
+	}
+
+	parts := strings.Fields(trimmed)
+	if len(parts) != len(cronStandardFields) {
+		return fmt.Errorf(
+			"invalid cron expression %q: expected 5 fields, got %d",
+			expression, len(parts))
+	}
+
+	for i, part := range parts {
+		err := cronStandardFields[i].validate(part)
+		if err != nil {
+			return fmt.Errorf(
+				"invalid cron expression %q: field %d (%s): %v",
+				expression, i+1, cronStandardFields[i].name, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks a single cron field, which may be a comma-separated list
+// of values, ranges and steps.
+func (f cronField) validate(field string) error {
+	for _, entry := range strings.Split(field, ",") {
+		err := f.validateEntry(entry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) validateEntry(entry string) error {
+	value := entry
+	if index := strings.Index(entry, "/"); index != -1 {
+		step := entry[index+1:]
+		value = entry[:index]
+		number, err := strconv.Atoi(step)
+		if err != nil || number < 1 {
+			return fmt.Errorf("bad step %q", step)
+		}
+	}
+
+	if value == "*" {
+		return nil
+	}
+
+	bounds := strings.SplitN(value, "-", 2)
+	low, err := f.number(bounds[0])
+	if err != nil {
+		return err
+	}
+
+	high := low
+	if len(bounds) == 2 {
+		high, err = f.number(bounds[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	if low > high {
+		return fmt.Errorf("bad range %q", value)
+	}
+
+	return nil
+}
+
+// number resolves a single value of the field, symbolic names included, and
+// checks it against the field bounds.
+func (f cronField) number(token string) (int, error) {
+	if value, ok := f.names[strings.ToLower(token)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(token)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("bad value %q", token)
+	}
+	return value, nil
+}
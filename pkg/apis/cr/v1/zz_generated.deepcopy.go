@@ -24,13 +24,55 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InlineScheduleSpec) DeepCopyInto(out *InlineScheduleSpec) {
+	*out = *in
+	in.MySQLBackupScheduleSpec.DeepCopyInto(&out.MySQLBackupScheduleSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InlineScheduleSpec.
+func (in *InlineScheduleSpec) DeepCopy() *InlineScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InlineScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupDestination) DeepCopyInto(out *MySQLBackupDestination) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLBackupSecretRef)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupDestination.
+func (in *MySQLBackupDestination) DeepCopy() *MySQLBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLBackupInstance) DeepCopyInto(out *MySQLBackupInstance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -88,6 +130,15 @@ func (in *MySQLBackupInstanceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLBackupInstanceSpec) DeepCopyInto(out *MySQLBackupInstanceSpec) {
 	*out = *in
+	if in.BackupTimeoutSeconds != nil {
+		in, out := &in.BackupTimeoutSeconds, &out.BackupTimeoutSeconds
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int64)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -104,6 +155,19 @@ func (in *MySQLBackupInstanceSpec) DeepCopy() *MySQLBackupInstanceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLBackupInstanceStatus) DeepCopyInto(out *MySQLBackupInstanceStatus) {
 	*out = *in
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.BackupTime.DeepCopyInto(&out.BackupTime)
+	if in.ExportedTables != nil {
+		in, out := &in.ExportedTables, &out.ExportedTables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -123,6 +187,7 @@ func (in *MySQLBackupSchedule) DeepCopyInto(out *MySQLBackupSchedule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -181,6 +246,46 @@ func (in *MySQLBackupScheduleList) DeepCopyObject() runtime.Object {
 func (in *MySQLBackupScheduleSpec) DeepCopyInto(out *MySQLBackupScheduleSpec) {
 	*out = *in
 	out.Storage = in.Storage.DeepCopy()
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]MySQLBackupDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.SecretEncryptionKeyRef != nil {
+		in, out := &in.SecretEncryptionKeyRef, &out.SecretEncryptionKeyRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLBackupSecretRef)
+			**out = **in
+		}
+	}
+	if in.CSVTables != nil {
+		in, out := &in.CSVTables, &out.CSVTables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int64)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -194,13 +299,54 @@ func (in *MySQLBackupScheduleSpec) DeepCopy() *MySQLBackupScheduleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupScheduleStatus) DeepCopyInto(out *MySQLBackupScheduleStatus) {
+	*out = *in
+	if in.StorageReachable != nil {
+		in, out := &in.StorageReachable, &out.StorageReachable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupScheduleStatus.
+func (in *MySQLBackupScheduleStatus) DeepCopy() *MySQLBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupSecretRef) DeepCopyInto(out *MySQLBackupSecretRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupSecretRef.
+func (in *MySQLBackupSecretRef) DeepCopy() *MySQLBackupSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLCluster) DeepCopyInto(out *MySQLCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -222,6 +368,23 @@ func (in *MySQLCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterAuditEntry) DeepCopyInto(out *MySQLClusterAuditEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterAuditEntry.
+func (in *MySQLClusterAuditEntry) DeepCopy() *MySQLClusterAuditEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterAuditEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLClusterList) DeepCopyInto(out *MySQLClusterList) {
 	*out = *in
@@ -255,10 +418,393 @@ func (in *MySQLClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterInitScript) DeepCopyInto(out *MySQLClusterInitScript) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterInitScript.
+func (in *MySQLClusterInitScript) DeepCopy() *MySQLClusterInitScript {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterInitScript)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterCondition) DeepCopyInto(out *MySQLClusterCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterCondition.
+func (in *MySQLClusterCondition) DeepCopy() *MySQLClusterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterDNSConfig) DeepCopyInto(out *MySQLClusterDNSConfig) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]MySQLClusterDNSOption, len(*in))
+		copy(*out, *in)
+	}
+	if in.Searches != nil {
+		in, out := &in.Searches, &out.Searches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterDNSConfig.
+func (in *MySQLClusterDNSConfig) DeepCopy() *MySQLClusterDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterDNSOption) DeepCopyInto(out *MySQLClusterDNSOption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterDNSOption.
+func (in *MySQLClusterDNSOption) DeepCopy() *MySQLClusterDNSOption {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterDNSOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterExtraMount) DeepCopyInto(out *MySQLClusterExtraMount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterExtraMount.
+func (in *MySQLClusterExtraMount) DeepCopy() *MySQLClusterExtraMount {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterExtraMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterMemberStatus) DeepCopyInto(out *MySQLClusterMemberStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterMemberStatus.
+func (in *MySQLClusterMemberStatus) DeepCopy() *MySQLClusterMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterMetrics) DeepCopyInto(out *MySQLClusterMetrics) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterMetrics.
+func (in *MySQLClusterMetrics) DeepCopy() *MySQLClusterMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterMigrationGate) DeepCopyInto(out *MySQLClusterMigrationGate) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterMigrationGate.
+func (in *MySQLClusterMigrationGate) DeepCopy() *MySQLClusterMigrationGate {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterMigrationGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterPVCRetentionPolicy) DeepCopyInto(out *MySQLClusterPVCRetentionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterPVCRetentionPolicy.
+func (in *MySQLClusterPVCRetentionPolicy) DeepCopy() *MySQLClusterPVCRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterPVCRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterProxy) DeepCopyInto(out *MySQLClusterProxy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterProxy.
+func (in *MySQLClusterProxy) DeepCopy() *MySQLClusterProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterReadinessGate) DeepCopyInto(out *MySQLClusterReadinessGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterReadinessGate.
+func (in *MySQLClusterReadinessGate) DeepCopy() *MySQLClusterReadinessGate {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterReadinessGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterResourceRecommendation) DeepCopyInto(out *MySQLClusterResourceRecommendation) {
+	*out = *in
+	out.CPU = in.CPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+	in.ObservedAt.DeepCopyInto(&out.ObservedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterResourceRecommendation.
+func (in *MySQLClusterResourceRecommendation) DeepCopy() *MySQLClusterResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLClusterSlowQueryLog) DeepCopyInto(out *MySQLClusterSlowQueryLog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLClusterSlowQueryLog.
+func (in *MySQLClusterSlowQueryLog) DeepCopy() *MySQLClusterSlowQueryLog {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLClusterSlowQueryLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLClusterSpec) DeepCopyInto(out *MySQLClusterSpec) {
 	*out = *in
 	out.Storage = in.Storage.DeepCopy()
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RestoreDatabases != nil {
+		in, out := &in.RestoreDatabases, &out.RestoreDatabases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterMetrics)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.InitScript != nil {
+		in, out := &in.InitScript, &out.InitScript
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterInitScript)
+			**out = **in
+		}
+	}
+	if in.MigrationGate != nil {
+		in, out := &in.MigrationGate, &out.MigrationGate
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterMigrationGate)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.FSGroupChangePolicy != nil {
+		in, out := &in.FSGroupChangePolicy, &out.FSGroupChangePolicy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.HostNetwork != nil {
+		in, out := &in.HostNetwork, &out.HostNetwork
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterDNSConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterProxy)
+			**out = **in
+		}
+	}
+	if in.PVCRetentionPolicy != nil {
+		in, out := &in.PVCRetentionPolicy, &out.PVCRetentionPolicy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterPVCRetentionPolicy)
+			**out = **in
+		}
+	}
+	if in.SlowQueryLog != nil {
+		in, out := &in.SlowQueryLog, &out.SlowQueryLog
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterSlowQueryLog)
+			**out = **in
+		}
+	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.WriteServiceAnnotations != nil {
+		in, out := &in.WriteServiceAnnotations, &out.WriteServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ReadServiceAnnotations != nil {
+		in, out := &in.ReadServiceAnnotations, &out.ReadServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtraManifests != nil {
+		in, out := &in.ExtraManifests, &out.ExtraManifests
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackupSchedules != nil {
+		in, out := &in.BackupSchedules, &out.BackupSchedules
+		*out = make([]InlineScheduleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraConfigMapMounts != nil {
+		in, out := &in.ExtraConfigMapMounts, &out.ExtraConfigMapMounts
+		*out = make([]MySQLClusterExtraMount, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraSecretMounts != nil {
+		in, out := &in.ExtraSecretMounts, &out.ExtraSecretMounts
+		*out = make([]MySQLClusterExtraMount, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]MySQLClusterReadinessGate, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -275,6 +821,55 @@ func (in *MySQLClusterSpec) DeepCopy() *MySQLClusterSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLClusterStatus) DeepCopyInto(out *MySQLClusterStatus) {
 	*out = *in
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.RestoredDatabases != nil {
+		in, out := &in.RestoredDatabases, &out.RestoredDatabases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		*out = make([]MySQLClusterAuditEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]MySQLClusterMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]MySQLClusterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceRecommendation != nil {
+		in, out := &in.ResourceRecommendation, &out.ResourceRecommendation
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MySQLClusterResourceRecommendation)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.UnhealthySince != nil {
+		in, out := &in.UnhealthySince, &out.UnhealthySince
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
 	return
 }
 
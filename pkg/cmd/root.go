@@ -42,6 +42,7 @@ func init() {
 
 	rootCmd.AddCommand(cluster.Cmd)
 	rootCmd.AddCommand(backup.Cmd)
+	rootCmd.AddCommand(selftestCmd)
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grtl/mysql-operator/pkg/cmd/util/config"
+	"github.com/grtl/mysql-operator/pkg/cmd/util/fail"
+	"github.com/grtl/mysql-operator/pkg/cmd/util/options"
+	"github.com/grtl/mysql-operator/pkg/selftest"
+)
+
+var selftestCmd = &cobra.Command{
+	Use:   "selftest",
+	Short: "Runs the operator self-test",
+	Long: `Runs a throwaway MySQL cluster through its full lifecycle -
+create, update, scale, backup, delete - and verifies the expected
+resources after every step. Use it as a smoke test after installing
+the operator. The test cluster is removed again by the final step.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := options.ExtractOptions(cmd)
+		cfg := config.GetConfig()
+
+		err := selftest.Run(cfg.Clientset(), cfg.KubeClientset(), opts.Namespace)
+		if err != nil {
+			fail.Error(err)
+		}
+
+		fmt.Println("Self-test passed.")
+	},
+}
@@ -0,0 +1,307 @@
+// Package config loads operator runtime tuning from the environment, so the
+// operator can be adjusted via Deployment env without code changes.
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Environment variables the configuration is read from.
+const (
+	ResyncEnv   = "MYSQL_OPERATOR_RESYNC"
+	WorkersEnv  = "MYSQL_OPERATOR_WORKERS"
+	LogLevelEnv = "MYSQL_OPERATOR_LOG_LEVEL"
+	// SecretNamespacesEnv is a comma-separated allowlist of namespaces
+	// cross-namespace secret references may point into.
+	SecretNamespacesEnv = "MYSQL_OPERATOR_SECRET_NAMESPACES"
+	// RegistryPrefixEnv is a registry prefix prepended to the images the
+	// operator deploys, e.g. "registry.example.com/mirror".
+	RegistryPrefixEnv = "MYSQL_OPERATOR_REGISTRY_PREFIX"
+	// ShardIndexEnv and ShardCountEnv shard cluster management across
+	// several operator instances: each instance only reconciles the clusters
+	// whose UID hash maps to its shard index.
+	ShardIndexEnv = "MYSQL_OPERATOR_SHARD_INDEX"
+	ShardCountEnv = "MYSQL_OPERATOR_SHARD_COUNT"
+	// TraceEndpointEnv is the HTTP endpoint reconcile trace spans are
+	// exported to. Tracing is disabled when unset.
+	TraceEndpointEnv = "MYSQL_OPERATOR_TRACE_ENDPOINT"
+	// MetricsAddrEnv is the listen address the operator serves its own
+	// Prometheus metrics on, e.g. ":8080". The endpoint is disabled when
+	// unset.
+	MetricsAddrEnv = "MYSQL_OPERATOR_METRICS_ADDR"
+	// RevisionGCLimitEnv is the number of StatefulSet ControllerRevisions
+	// kept per cluster by the operator-side GC pass. The pass is disabled
+	// when unset.
+	RevisionGCLimitEnv = "MYSQL_OPERATOR_REVISION_GC_LIMIT"
+	// ErrorThrottleEnv is the minimum interval between identical reconcile
+	// errors logged for the same cluster, e.g. "5m". "0" logs every error.
+	ErrorThrottleEnv = "MYSQL_OPERATOR_ERROR_THROTTLE"
+	// StoragePressureThresholdEnv is the data volume usage percentage at
+	// which a cluster gets the StoragePressure condition, e.g. "85". The
+	// check is disabled when unset.
+	StoragePressureThresholdEnv = "MYSQL_OPERATOR_STORAGE_PRESSURE_THRESHOLD"
+	// RecommendResourcesEnv enables the advisory resource recommendation
+	// pass when set to "true". The pass needs the metrics API
+	// (metrics.k8s.io) to be served in the cluster.
+	RecommendResourcesEnv = "MYSQL_OPERATOR_RECOMMEND_RESOURCES"
+	// CheckZoneAffinityEnv enables the zone affinity check when set to
+	// "true". The check flags clusters with a member pod running in a
+	// different zone than its bound data volume.
+	CheckZoneAffinityEnv = "MYSQL_OPERATOR_CHECK_ZONE_AFFINITY"
+	// DefaultAnnotationsEnv is a comma-separated list of key=value
+	// annotations stamped on every object the operator creates, e.g.
+	// "backup-exclude=true,mesh-inject=false". Annotations the operator
+	// renders itself win over these defaults.
+	DefaultAnnotationsEnv = "MYSQL_OPERATOR_DEFAULT_ANNOTATIONS"
+)
+
+// Default values used when an environment variable is unset or invalid.
+const (
+	// DefaultResync disables periodic informer resyncs.
+	DefaultResync time.Duration = 0
+	// DefaultWorkers processes controller events sequentially.
+	DefaultWorkers = 1
+	// DefaultShardIndex and DefaultShardCount run a single, unsharded
+	// operator instance.
+	DefaultShardIndex = 0
+	DefaultShardCount = 1
+	// DefaultErrorThrottle keeps a permanently failing cluster from
+	// repeating the same error every reconcile.
+	DefaultErrorThrottle = 5 * time.Minute
+)
+
+// DefaultLogLevel is the level the operator logs at when none is configured.
+var DefaultLogLevel = logrus.InfoLevel
+
+// Config holds the operator runtime tuning.
+type Config struct {
+	// Resync is the shared informer resync period. Zero disables periodic
+	// resyncs.
+	Resync time.Duration
+	// Workers is the number of goroutines each controller processes events
+	// with.
+	Workers int
+	// LogLevel is the level the operator logs at.
+	LogLevel logrus.Level
+	// SecretNamespaces lists the namespaces cross-namespace secret
+	// references are allowed to point into. Empty forbids them.
+	SecretNamespaces []string
+	// RegistryPrefix is prepended to the images the operator deploys, unless
+	// an image is already fully qualified. Empty leaves the images unchanged.
+	RegistryPrefix string
+	// ShardIndex is this instance's shard among ShardCount operator
+	// instances. With a ShardCount of 1 every cluster is owned.
+	ShardIndex int
+	ShardCount int
+	// TraceEndpoint is the HTTP endpoint reconcile trace spans are exported
+	// to. Empty disables tracing.
+	TraceEndpoint string
+	// MetricsAddr is the listen address the operator serves its own
+	// Prometheus metrics on. Empty disables the endpoint.
+	MetricsAddr string
+	// RevisionGCLimit is the number of StatefulSet ControllerRevisions kept
+	// per cluster by the operator-side GC pass. Zero disables the pass and
+	// leaves pruning to the StatefulSet controller alone.
+	RevisionGCLimit int
+	// ErrorThrottle is the minimum interval between identical reconcile
+	// errors logged for the same cluster. Zero logs every error.
+	ErrorThrottle time.Duration
+	// StoragePressureThreshold is the data volume usage percentage at which
+	// a cluster gets the StoragePressure condition. Zero disables the check.
+	StoragePressureThreshold int
+	// RecommendResources enables the advisory resource recommendation pass,
+	// which periodically writes observed-usage right-sizing hints into the
+	// cluster statuses.
+	RecommendResources bool
+	// CheckZoneAffinity enables the zone affinity check, which flags
+	// clusters with a member pod running in a different zone than its bound
+	// data volume.
+	CheckZoneAffinity bool
+	// DefaultAnnotations are stamped on every object the operator creates,
+	// so admins can enforce org-wide annotation policy. Annotations the
+	// operator renders itself win over these defaults.
+	DefaultAnnotations map[string]string
+}
+
+// SecretNamespaceAllowed returns true when secrets may be read from the
+// given namespace.
+func (c Config) SecretNamespaceAllowed(namespace string) bool {
+	for _, allowed := range c.SecretNamespaces {
+		if allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	once    sync.Once
+	current Config
+)
+
+// Get returns the configuration read from the environment. The environment
+// is read once, on first use.
+func Get() Config {
+	once.Do(func() {
+		current = FromEnvironment()
+	})
+	return current
+}
+
+// FromEnvironment reads the configuration from environment variables. An
+// unset or invalid variable falls back to its default, the latter with a
+// warning.
+func FromEnvironment() Config {
+	conf := Config{
+		Resync:        DefaultResync,
+		Workers:       DefaultWorkers,
+		LogLevel:      DefaultLogLevel,
+		ShardIndex:    DefaultShardIndex,
+		ShardCount:    DefaultShardCount,
+		ErrorThrottle: DefaultErrorThrottle,
+	}
+
+	if value, ok := os.LookupEnv(ResyncEnv); ok {
+		resync, err := time.ParseDuration(value)
+		if err != nil || resync < 0 {
+			warnInvalid(ResyncEnv, value)
+		} else {
+			conf.Resync = resync
+		}
+	}
+
+	if value, ok := os.LookupEnv(WorkersEnv); ok {
+		workers, err := strconv.Atoi(value)
+		if err != nil || workers < 1 {
+			warnInvalid(WorkersEnv, value)
+		} else {
+			conf.Workers = workers
+		}
+	}
+
+	if value, ok := os.LookupEnv(LogLevelEnv); ok {
+		level, err := logrus.ParseLevel(value)
+		if err != nil {
+			warnInvalid(LogLevelEnv, value)
+		} else {
+			conf.LogLevel = level
+		}
+	}
+
+	if value, ok := os.LookupEnv(SecretNamespacesEnv); ok {
+		for _, namespace := range strings.Split(value, ",") {
+			namespace = strings.TrimSpace(namespace)
+			if namespace != "" {
+				conf.SecretNamespaces = append(conf.SecretNamespaces, namespace)
+			}
+		}
+	}
+
+	if value, ok := os.LookupEnv(RegistryPrefixEnv); ok {
+		conf.RegistryPrefix = strings.TrimSuffix(strings.TrimSpace(value), "/")
+	}
+
+	if value, ok := os.LookupEnv(TraceEndpointEnv); ok {
+		conf.TraceEndpoint = strings.TrimSpace(value)
+	}
+
+	if value, ok := os.LookupEnv(MetricsAddrEnv); ok {
+		conf.MetricsAddr = strings.TrimSpace(value)
+	}
+
+	if value, ok := os.LookupEnv(ErrorThrottleEnv); ok {
+		throttle, err := time.ParseDuration(value)
+		if err != nil || throttle < 0 {
+			warnInvalid(ErrorThrottleEnv, value)
+		} else {
+			conf.ErrorThrottle = throttle
+		}
+	}
+
+	if value, ok := os.LookupEnv(StoragePressureThresholdEnv); ok {
+		threshold, err := strconv.Atoi(value)
+		if err != nil || threshold < 1 || threshold > 100 {
+			warnInvalid(StoragePressureThresholdEnv, value)
+		} else {
+			conf.StoragePressureThreshold = threshold
+		}
+	}
+
+	if value, ok := os.LookupEnv(RecommendResourcesEnv); ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			warnInvalid(RecommendResourcesEnv, value)
+		} else {
+			conf.RecommendResources = enabled
+		}
+	}
+
+	if value, ok := os.LookupEnv(CheckZoneAffinityEnv); ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			warnInvalid(CheckZoneAffinityEnv, value)
+		} else {
+			conf.CheckZoneAffinity = enabled
+		}
+	}
+
+	if value, ok := os.LookupEnv(DefaultAnnotationsEnv); ok {
+		for _, pair := range strings.Split(value, ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				warnInvalid(DefaultAnnotationsEnv, pair)
+				continue
+			}
+			if conf.DefaultAnnotations == nil {
+				conf.DefaultAnnotations = map[string]string{}
+			}
+			conf.DefaultAnnotations[parts[0]] = parts[1]
+		}
+	}
+
+	if value, ok := os.LookupEnv(RevisionGCLimitEnv); ok {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			warnInvalid(RevisionGCLimitEnv, value)
+		} else {
+			conf.RevisionGCLimit = limit
+		}
+	}
+
+	if value, ok := os.LookupEnv(ShardCountEnv); ok {
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 1 {
+			warnInvalid(ShardCountEnv, value)
+		} else {
+			conf.ShardCount = count
+		}
+	}
+
+	if value, ok := os.LookupEnv(ShardIndexEnv); ok {
+		index, err := strconv.Atoi(value)
+		if err != nil || index < 0 || index >= conf.ShardCount {
+			warnInvalid(ShardIndexEnv, value)
+		} else {
+			conf.ShardIndex = index
+		}
+	}
+
+	return conf
+}
+
+func warnInvalid(variable, value string) {
+	logrus.WithFields(logrus.Fields{
+		"variable": variable,
+		"value":    value,
+	}).Warn("Invalid configuration value, using the default")
+}
@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/grtl/mysql-operator/pkg/config"
+)
+
+var _ = Describe("FromEnvironment", func() {
+	AfterEach(func() {
+		os.Unsetenv(config.ResyncEnv)
+		os.Unsetenv(config.WorkersEnv)
+		os.Unsetenv(config.LogLevelEnv)
+	})
+
+	It("returns the defaults for an empty environment", func() {
+		conf := config.FromEnvironment()
+		Expect(conf.Resync).To(Equal(config.DefaultResync))
+		Expect(conf.Workers).To(Equal(config.DefaultWorkers))
+		Expect(conf.LogLevel).To(Equal(config.DefaultLogLevel))
+	})
+
+	It("parses the configured values", func() {
+		os.Setenv(config.ResyncEnv, "5m")
+		os.Setenv(config.WorkersEnv, "4")
+		os.Setenv(config.LogLevelEnv, "debug")
+
+		conf := config.FromEnvironment()
+		Expect(conf.Resync).To(Equal(5 * time.Minute))
+		Expect(conf.Workers).To(Equal(4))
+		Expect(conf.LogLevel).To(Equal(logrus.DebugLevel))
+	})
+
+	It("parses the default annotations", func() {
+		os.Setenv(config.DefaultAnnotationsEnv,
+			"backup-exclude=true, mesh-inject=false")
+		defer os.Unsetenv(config.DefaultAnnotationsEnv)
+
+		conf := config.FromEnvironment()
+		Expect(conf.DefaultAnnotations).To(Equal(map[string]string{
+			"backup-exclude": "true",
+			"mesh-inject":    "false",
+		}))
+	})
+
+	It("skips a malformed default annotation", func() {
+		os.Setenv(config.DefaultAnnotationsEnv, "no-value,key=ok")
+		defer os.Unsetenv(config.DefaultAnnotationsEnv)
+
+		conf := config.FromEnvironment()
+		Expect(conf.DefaultAnnotations).To(Equal(map[string]string{
+			"key": "ok",
+		}))
+	})
+
+	It("falls back to the defaults on invalid values", func() {
+		os.Setenv(config.ResyncEnv, "often")
+		os.Setenv(config.WorkersEnv, "-1")
+		os.Setenv(config.LogLevelEnv, "loud")
+
+		conf := config.FromEnvironment()
+		Expect(conf.Resync).To(Equal(config.DefaultResync))
+		Expect(conf.Workers).To(Equal(config.DefaultWorkers))
+		Expect(conf.LogLevel).To(Equal(config.DefaultLogLevel))
+	})
+})
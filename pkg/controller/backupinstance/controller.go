@@ -9,6 +9,7 @@ import (
 	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
 	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
 	"github.com/grtl/mysql-operator/pkg/client/informers/externalversions"
+	"github.com/grtl/mysql-operator/pkg/config"
 	"github.com/grtl/mysql-operator/pkg/controller"
 	"github.com/grtl/mysql-operator/pkg/logging"
 	"github.com/grtl/mysql-operator/pkg/operator/backupinstance"
@@ -30,13 +31,17 @@ type backupInstanceController struct {
 }
 
 func (c *backupInstanceController) Run(ctx context.Context) error {
-	factory := externalversions.NewSharedInformerFactory(c.clientset, 0)
+	conf := config.Get()
+	dispatcher := controller.NewDispatcher(conf.Workers)
+	defer dispatcher.Stop()
+
+	factory := externalversions.NewSharedInformerFactory(c.clientset, conf.Resync)
 	informer := factory.Cr().V1().MySQLBackupInstances().Informer()
-	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	informer.AddEventHandler(dispatcher.HandlerFuncs(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onAdd,
 		UpdateFunc: c.onUpdate,
 		DeleteFunc: c.onDelete,
-	})
+	}))
 	informer.Run(ctx.Done())
 	<-ctx.Done()
 	return ctx.Err()
@@ -61,10 +66,20 @@ func (c *backupInstanceController) onAdd(obj interface{}) {
 }
 
 func (c *backupInstanceController) onUpdate(oldObj, newObj interface{}) {
+	oldBackup := oldObj.(*crv1.MySQLBackupInstance)
 	newBackup := newObj.(*crv1.MySQLBackupInstance)
 
 	logBackupInstanceEventBegin(newBackup, BackupInstanceUpdated)
 
+	// A backup just completed - stamp the cluster's LastBackupTime.
+	if newBackup.Status.Phase == crv1.MySQLBackupCompleted &&
+		oldBackup.Status.Phase != crv1.MySQLBackupCompleted {
+		err := c.operator.RecordBackupCompleted(newBackup)
+		if err != nil {
+			logging.LogBackupInstance(newBackup).WithField("event", BackupInstanceUpdated).Error(err)
+		}
+	}
+
 	logBackupInstanceEventSuccess(newBackup, BackupInstanceUpdated)
 
 	// Run hooks
@@ -2,13 +2,19 @@ package backupschedule
 
 import (
 	"context"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/sirupsen/logrus"
+
 	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
 	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
 	"github.com/grtl/mysql-operator/pkg/client/informers/externalversions"
+	"github.com/grtl/mysql-operator/pkg/config"
 	"github.com/grtl/mysql-operator/pkg/controller"
 	"github.com/grtl/mysql-operator/pkg/logging"
 	operator "github.com/grtl/mysql-operator/pkg/operator/backupschedule"
@@ -30,18 +36,47 @@ type backupScheduleController struct {
 }
 
 func (c *backupScheduleController) Run(ctx context.Context) error {
-	factory := externalversions.NewSharedInformerFactory(c.clientset, 0)
+	conf := config.Get()
+	dispatcher := controller.NewDispatcher(conf.Workers)
+	defer dispatcher.Stop()
+
+	factory := externalversions.NewSharedInformerFactory(c.clientset, conf.Resync)
 	informer := factory.Cr().V1().MySQLBackupSchedules().Informer()
-	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	informer.AddEventHandler(dispatcher.HandlerFuncs(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onAdd,
 		UpdateFunc: c.onUpdate,
 		DeleteFunc: c.onDelete,
-	})
+	}))
+	go wait.Until(c.checkStorage, storageCheckInterval, ctx.Done())
 	informer.Run(ctx.Done())
 	<-ctx.Done()
 	return ctx.Err()
 }
 
+// storageCheckInterval is how often every schedule's storage destinations
+// are probed.
+const storageCheckInterval = 5 * time.Minute
+
+// checkStorage probes the storage destinations of all schedules and records
+// the reachability in their statuses.
+func (c *backupScheduleController) checkStorage() {
+	schedules, err := c.clientset.CrV1().
+		MySQLBackupSchedules(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logrus.WithError(err).Warn("Unable to list backup schedules for the storage check")
+		return
+	}
+
+	for i := range schedules.Items {
+		schedule := &schedules.Items[i]
+		err = c.operator.CheckStorage(schedule)
+		if err != nil {
+			logging.LogBackupSchedule(schedule).WithField(
+				"fail", err).Warn("Unable to check the backup storage")
+		}
+	}
+}
+
 func (c *backupScheduleController) onAdd(obj interface{}) {
 	schedule := obj.(*crv1.MySQLBackupSchedule)
 
@@ -65,7 +100,12 @@ func (c *backupScheduleController) onUpdate(oldObj, newObj interface{}) {
 
 	logBackupScheduleEventBegin(newSchedule, BackupScheduleUpdated)
 
-	logBackupScheduleEventSuccess(newSchedule, BackupScheduleUpdated)
+	err := c.operator.UpdateBackupSchedule(newSchedule)
+	if err != nil {
+		logging.LogBackupSchedule(newSchedule).WithField("event", BackupScheduleUpdated).Error(err)
+	} else {
+		logBackupScheduleEventSuccess(newSchedule, BackupScheduleUpdated)
+	}
 
 	// Run hooks
 	for _, hook := range c.GetHooks() {
@@ -2,15 +2,23 @@ package cluster
 
 import (
 	"context"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/sirupsen/logrus"
+
 	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
 	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
 	"github.com/grtl/mysql-operator/pkg/client/informers/externalversions"
+	"github.com/grtl/mysql-operator/pkg/config"
 	"github.com/grtl/mysql-operator/pkg/controller"
 	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/metrics"
 	operator "github.com/grtl/mysql-operator/pkg/operator/cluster"
 )
 
@@ -20,37 +28,213 @@ func NewClusterController(clientset versioned.Interface, kubeClientset kubernete
 		Base:            controller.NewControllerBase(),
 		clientset:       clientset,
 		clusterOperator: operator.NewClusterOperator(clientset, kubeClientset),
+		errors:          newErrorThrottle(config.Get().ErrorThrottle),
 	}
 }
 
+// NewClusterControllerForConfig returns a new cluster controller whose
+// operator is additionally able to create ServiceMonitor objects.
+func NewClusterControllerForConfig(clientset versioned.Interface,
+	kubeClientset kubernetes.Interface, restConfig *rest.Config) (controller.Controller, error) {
+	clusterOperator, err := operator.NewClusterOperatorForConfig(clientset, kubeClientset, restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterController{
+		Base:            controller.NewControllerBase(),
+		clientset:       clientset,
+		clusterOperator: clusterOperator,
+		errors:          newErrorThrottle(config.Get().ErrorThrottle),
+	}, nil
+}
+
 type clusterController struct {
 	controller.Base
 	clientset       versioned.Interface
 	clusterOperator operator.Operator
+	// errors rate-limits identical reconcile errors per cluster.
+	errors *errorThrottle
 }
 
 func (c *clusterController) Run(ctx context.Context) error {
-	factory := externalversions.NewSharedInformerFactory(c.clientset, 0)
+	conf := config.Get()
+	dispatcher := controller.NewDispatcher(conf.Workers)
+	defer dispatcher.Stop()
+
+	factory := externalversions.NewSharedInformerFactory(c.clientset, conf.Resync)
 	informer := factory.Cr().V1().MySQLClusters().Informer()
-	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	informer.AddEventHandler(dispatcher.HandlerFuncs(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onAdd,
 		UpdateFunc: c.onUpdate,
 		DeleteFunc: c.onDelete,
-	})
+	}))
+	go wait.Until(c.checkSplitBrain, splitBrainCheckInterval, ctx.Done())
+	go wait.Until(c.checkNodeMaintenance, nodeMaintenanceCheckInterval, ctx.Done())
+	if conf.StoragePressureThreshold > 0 {
+		go wait.Until(c.checkStoragePressure, storagePressureCheckInterval, ctx.Done())
+	}
+	if conf.RecommendResources {
+		go wait.Until(c.recommendResources, resourceRecommendInterval, ctx.Done())
+	}
+	if conf.CheckZoneAffinity {
+		go wait.Until(c.checkZoneAffinity, zoneAffinityCheckInterval, ctx.Done())
+	}
 	informer.Run(ctx.Done())
 	<-ctx.Done()
 	return ctx.Err()
 }
 
+// splitBrainCheckInterval is how often every cluster's members are checked
+// for multiple writable primaries.
+const splitBrainCheckInterval = time.Minute
+
+// checkSplitBrain runs the split brain check on every owned cluster.
+func (c *clusterController) checkSplitBrain() {
+	clusters, err := c.clientset.CrV1().
+		MySQLClusters(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logrus.WithError(err).Warn("Unable to list clusters for the split brain check")
+		return
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !controller.ShardOwned(cluster.UID) {
+			continue
+		}
+		err = c.clusterOperator.CheckSplitBrain(cluster)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to check the cluster for a split brain")
+		}
+	}
+}
+
+// nodeMaintenanceCheckInterval is how often the clusters are checked for a
+// primary on a cordoned node.
+const nodeMaintenanceCheckInterval = time.Minute
+
+// checkNodeMaintenance runs the node maintenance check on every owned
+// cluster, so a primary is switched over before its node is drained.
+func (c *clusterController) checkNodeMaintenance() {
+	clusters, err := c.clientset.CrV1().
+		MySQLClusters(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logrus.WithError(err).Warn("Unable to list clusters for the node maintenance check")
+		return
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !controller.ShardOwned(cluster.UID) {
+			continue
+		}
+		err = c.clusterOperator.CheckNodeMaintenance(cluster)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to check the cluster for node maintenance")
+		}
+	}
+}
+
+// storagePressureCheckInterval is how often the clusters' data volume usage
+// is read.
+const storagePressureCheckInterval = 5 * time.Minute
+
+// checkStoragePressure runs the storage pressure check on every owned
+// cluster, so a filling disk is flagged before it causes an outage.
+func (c *clusterController) checkStoragePressure() {
+	clusters, err := c.clientset.CrV1().
+		MySQLClusters(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logrus.WithError(err).Warn("Unable to list clusters for the storage pressure check")
+		return
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !controller.ShardOwned(cluster.UID) {
+			continue
+		}
+		err = c.clusterOperator.CheckStoragePressure(cluster)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to check the cluster for storage pressure")
+		}
+	}
+}
+
+// resourceRecommendInterval is how often the clusters' resource usage is
+// sampled for the advisory recommendations.
+const resourceRecommendInterval = 10 * time.Minute
+
+// recommendResources runs the resource recommendation pass on every owned
+// cluster.
+func (c *clusterController) recommendResources() {
+	clusters, err := c.clientset.CrV1().
+		MySQLClusters(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logrus.WithError(err).Warn("Unable to list clusters for the resource recommendations")
+		return
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !controller.ShardOwned(cluster.UID) {
+			continue
+		}
+		err = c.clusterOperator.RecommendResources(cluster)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to compute the cluster's resource recommendation")
+		}
+	}
+}
+
+// zoneAffinityCheckInterval is how often the clusters are checked for pods
+// running outside their data volume's zone.
+const zoneAffinityCheckInterval = 5 * time.Minute
+
+// checkZoneAffinity runs the zone affinity check on every owned cluster, so
+// a failover across zones is flagged before a reschedule gets stuck on the
+// volume zone predicate.
+func (c *clusterController) checkZoneAffinity() {
+	clusters, err := c.clientset.CrV1().
+		MySQLClusters(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logrus.WithError(err).Warn("Unable to list clusters for the zone affinity check")
+		return
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !controller.ShardOwned(cluster.UID) {
+			continue
+		}
+		err = c.clusterOperator.CheckZoneAffinity(cluster)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to check the cluster's zone affinity")
+		}
+	}
+}
+
 func (c *clusterController) onAdd(obj interface{}) {
 	cluster := obj.(*crv1.MySQLCluster)
 
+	// Another operator instance owns this cluster's shard.
+	if !controller.ShardOwned(cluster.UID) {
+		return
+	}
+
 	logClusterEventBegin(cluster, ClusterAdded)
 
 	err := c.clusterOperator.AddCluster(cluster)
 	if err != nil {
-		logging.LogCluster(cluster).WithField("event", ClusterAdded).Error(err)
+		c.logThrottledError(cluster, ClusterAdded, err)
 	} else {
+		c.errors.forget(cluster.UID)
 		logClusterEventSuccess(cluster, ClusterAdded)
 	}
 
@@ -63,12 +247,28 @@ func (c *clusterController) onAdd(obj interface{}) {
 func (c *clusterController) onUpdate(oldObj, newObj interface{}) {
 	newCluster := newObj.(*crv1.MySQLCluster)
 
+	// Another operator instance owns this cluster's shard.
+	if !controller.ShardOwned(newCluster.UID) {
+		return
+	}
+
+	// A cluster with a deletion timestamp is being removed - finalize it
+	// instead of reconciling the update.
+	if newCluster.DeletionTimestamp != nil {
+		err := c.clusterOperator.FinalizeCluster(newCluster)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField("event", ClusterUpdated).Error(err)
+		}
+		return
+	}
+
 	logClusterEventBegin(newCluster, ClusterUpdated)
 
 	err := c.clusterOperator.UpdateCluster(newCluster)
 	if err != nil {
-		logging.LogCluster(newCluster).WithField("event", ClusterUpdated).Error(err)
+		c.logThrottledError(newCluster, ClusterUpdated, err)
 	} else {
+		c.errors.forget(newCluster.UID)
 		logClusterEventSuccess(newCluster, ClusterUpdated)
 	}
 
@@ -81,8 +281,17 @@ func (c *clusterController) onUpdate(oldObj, newObj interface{}) {
 func (c *clusterController) onDelete(obj interface{}) {
 	cluster := obj.(*crv1.MySQLCluster)
 
+	// Another operator instance owns this cluster's shard.
+	if !controller.ShardOwned(cluster.UID) {
+		return
+	}
+
 	logClusterEventBegin(cluster, ClusterDeleted)
 
+	// Drop the phase gauge, so stale series do not keep firing alerts.
+	metrics.DeleteClusterPhase(cluster.Namespace, cluster.Name)
+	c.errors.forget(cluster.UID)
+
 	logClusterEventSuccess(cluster, ClusterDeleted)
 
 	// Run hooks
@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// errorThrottle rate-limits identical reconcile errors per cluster, so a
+// permanently failing cluster (e.g. one with an invalid image) does not
+// repeat the same line every loop. Errors are keyed on the cluster UID and
+// message; suppressed repeats are counted and the count is reported the next
+// time the error surfaces.
+type errorThrottle struct {
+	mutex sync.Mutex
+	// interval is the minimum time between two identical errors. Zero
+	// disables the throttle.
+	interval time.Duration
+	seen     map[errorKey]*errorRecord
+}
+
+type errorKey struct {
+	uid     types.UID
+	message string
+}
+
+type errorRecord struct {
+	lastLogged time.Time
+	suppressed int
+}
+
+func newErrorThrottle(interval time.Duration) *errorThrottle {
+	return &errorThrottle{
+		interval: interval,
+		seen:     map[errorKey]*errorRecord{},
+	}
+}
+
+// shouldLog reports whether the error should be logged now, and how many
+// identical errors were suppressed since it was last logged.
+func (t *errorThrottle) shouldLog(uid types.UID, message string) (bool, int) {
+	if t.interval <= 0 {
+		return true, 0
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := errorKey{uid: uid, message: message}
+	now := time.Now()
+	record, ok := t.seen[key]
+	if ok && now.Sub(record.lastLogged) < t.interval {
+		record.suppressed++
+		return false, 0
+	}
+
+	suppressed := 0
+	if ok {
+		suppressed = record.suppressed
+	}
+	t.seen[key] = &errorRecord{lastLogged: now}
+	return true, suppressed
+}
+
+// forget drops the throttle state of the cluster, so a recovered or deleted
+// cluster does not keep its records around.
+func (t *errorThrottle) forget(uid types.UID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for key := range t.seen {
+		if key.uid == uid {
+			delete(t.seen, key)
+		}
+	}
+}
+
+// logThrottledError logs a reconcile error unless an identical one was
+// logged for the cluster recently.
+func (c *clusterController) logThrottledError(cluster *crv1.MySQLCluster, event Event, err error) {
+	log, suppressed := c.errors.shouldLog(cluster.UID, err.Error())
+	if !log {
+		return
+	}
+
+	entry := logging.LogCluster(cluster).WithField("event", event)
+	if suppressed > 0 {
+		entry = entry.WithField("repeats", suppressed)
+	}
+	entry.Error(err)
+}
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("ErrorThrottle", func() {
+	uid := types.UID("cluster-uid")
+
+	It("suppresses identical errors within the interval", func() {
+		throttle := newErrorThrottle(time.Hour)
+
+		log, _ := throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+
+		log, _ = throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeFalse())
+	})
+
+	It("lets a different error through", func() {
+		throttle := newErrorThrottle(time.Hour)
+
+		log, _ := throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+
+		log, _ = throttle.shouldLog(uid, "quota exceeded")
+		Expect(log).To(BeTrue())
+	})
+
+	It("reports the suppressed count once the interval passed", func() {
+		throttle := newErrorThrottle(time.Millisecond)
+
+		log, _ := throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+		throttle.shouldLog(uid, "pull failed")
+		throttle.shouldLog(uid, "pull failed")
+
+		time.Sleep(2 * time.Millisecond)
+
+		log, suppressed := throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+		Expect(suppressed).To(Equal(2))
+	})
+
+	It("starts fresh after a forget", func() {
+		throttle := newErrorThrottle(time.Hour)
+
+		throttle.shouldLog(uid, "pull failed")
+		throttle.forget(uid)
+
+		log, suppressed := throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+		Expect(suppressed).To(Equal(0))
+	})
+
+	It("is disabled with a zero interval", func() {
+		throttle := newErrorThrottle(0)
+
+		log, _ := throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+		log, _ = throttle.shouldLog(uid, "pull failed")
+		Expect(log).To(BeTrue())
+	})
+})
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"k8s.io/client-go/tools/cache"
+)
+
+// Dispatcher fans controller events out to a fixed number of workers.
+type Dispatcher struct {
+	tasks chan func()
+}
+
+// NewDispatcher returns a Dispatcher processing tasks with the given number
+// of workers.
+func NewDispatcher(workers int) *Dispatcher {
+	dispatcher := &Dispatcher{
+		tasks: make(chan func(), workers),
+	}
+	for i := 0; i < workers; i++ {
+		go dispatcher.work()
+	}
+	return dispatcher
+}
+
+// HandlerFuncs wraps the given event handlers so the events are processed by
+// the dispatcher's workers.
+func (d *Dispatcher) HandlerFuncs(handlers cache.ResourceEventHandlerFuncs) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			d.dispatch(func() { handlers.AddFunc(obj) })
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			d.dispatch(func() { handlers.UpdateFunc(oldObj, newObj) })
+		},
+		DeleteFunc: func(obj interface{}) {
+			d.dispatch(func() { handlers.DeleteFunc(obj) })
+		},
+	}
+}
+
+// Stop makes the workers exit once the already dispatched tasks are
+// processed.
+func (d *Dispatcher) Stop() {
+	close(d.tasks)
+}
+
+func (d *Dispatcher) dispatch(task func()) {
+	d.tasks <- task
+}
+
+func (d *Dispatcher) work() {
+	for task := range d.tasks {
+		task()
+	}
+}
@@ -0,0 +1,40 @@
+package controller_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/grtl/mysql-operator/pkg/controller"
+)
+
+var _ = Describe("Dispatcher", func() {
+	It("processes every dispatched event", func() {
+		dispatcher := NewDispatcher(2)
+		defer dispatcher.Stop()
+
+		var lock sync.Mutex
+		processed := 0
+		done := make(chan struct{})
+
+		handlers := dispatcher.HandlerFuncs(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				lock.Lock()
+				defer lock.Unlock()
+				processed++
+				if processed == 10 {
+					close(done)
+				}
+			},
+		})
+
+		for i := 0; i < 10; i++ {
+			handlers.AddFunc(nil)
+		}
+
+		Eventually(done).Should(BeClosed())
+	})
+})
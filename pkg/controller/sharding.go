@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"hash/fnv"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/grtl/mysql-operator/pkg/config"
+)
+
+// ShardOwned returns true when the object with the given UID maps to this
+// operator instance's shard. With an unsharded configuration every object is
+// owned.
+func ShardOwned(uid types.UID) bool {
+	conf := config.Get()
+	return shardOwned(uid, conf.ShardIndex, conf.ShardCount)
+}
+
+// shardOwned maps the given UID to one of count shards by a consistent hash
+// and returns true when it maps to the given index.
+func shardOwned(uid types.UID, index, count int) bool {
+	if count <= 1 {
+		return true
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(uid))
+	return int(hash.Sum32())%count == index
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Sharding", func() {
+	It("owns everything when unsharded", func() {
+		Expect(shardOwned(types.UID("any-uid"), 0, 1)).To(BeTrue())
+	})
+
+	It("assigns every UID to exactly one shard", func() {
+		const count = 3
+		for i := 0; i < 100; i++ {
+			uid := types.UID(fmt.Sprintf("cluster-uid-%d", i))
+
+			owners := 0
+			for index := 0; index < count; index++ {
+				if shardOwned(uid, index, count) {
+					owners++
+				}
+			}
+			Expect(owners).To(Equal(1))
+		}
+	})
+
+	It("distributes UIDs across shards", func() {
+		const count = 3
+		owned := make([]int, count)
+		for i := 0; i < 100; i++ {
+			uid := types.UID(fmt.Sprintf("cluster-uid-%d", i))
+			for index := 0; index < count; index++ {
+				if shardOwned(uid, index, count) {
+					owned[index]++
+				}
+			}
+		}
+
+		for index := 0; index < count; index++ {
+			Expect(owned[index]).To(BeNumerically(">", 0))
+		}
+	})
+
+	It("hashes consistently", func() {
+		uid := types.UID("stable-uid")
+		first := shardOwned(uid, 0, 3)
+		Expect(shardOwned(uid, 0, 3)).To(Equal(first))
+	})
+})
@@ -0,0 +1,107 @@
+// Package metrics serves operator metrics in the Prometheus text exposition
+// format, so alerting rules can act on cluster health without scraping the
+// databases themselves. The endpoint is disabled unless a listen address is
+// configured.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/grtl/mysql-operator/pkg/config"
+)
+
+// Cluster phase gauge values. The mapping is part of the metrics contract -
+// alerting rules depend on it, keep it stable.
+const (
+	// PhaseCreating is a cluster still being brought up.
+	PhaseCreating = 0
+	// PhaseReady is a cluster serving traffic.
+	PhaseReady = 1
+	// PhaseUpdating is a cluster with a change in flight.
+	PhaseUpdating = 2
+	// PhaseDegraded is a cluster below its desired capacity.
+	PhaseDegraded = 3
+	// PhaseFailed is a cluster which needs operator intervention.
+	PhaseFailed = 4
+)
+
+type clusterKey struct {
+	namespace string
+	name      string
+}
+
+var (
+	mutex  sync.Mutex
+	phases = map[clusterKey]int{}
+)
+
+// SetClusterPhase records the phase gauge of the cluster.
+func SetClusterPhase(namespace, name string, phase int) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	phases[clusterKey{namespace: namespace, name: name}] = phase
+}
+
+// DeleteClusterPhase drops the gauge of a removed cluster, so stale series
+// do not keep firing alerts.
+func DeleteClusterPhase(namespace, name string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(phases, clusterKey{namespace: namespace, name: name})
+}
+
+// Handler serves the recorded metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprint(w, render())
+	})
+}
+
+// Serve starts the metrics endpoint in the background when a listen address
+// is configured. It is a no-op otherwise.
+func Serve() {
+	addr := config.Get().MetricsAddr
+	if addr == "" {
+		return
+	}
+
+	go func() {
+		err := http.ListenAndServe(addr, Handler())
+		if err != nil {
+			logrus.WithField("fail", err).Warn("Unable to serve the metrics endpoint")
+		}
+	}()
+}
+
+// render produces the text exposition. Series are sorted, so consecutive
+// scrapes of an unchanged state compare equal.
+func render() string {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	keys := make([]clusterKey, 0, len(phases))
+	for key := range phases {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].name < keys[j].name
+	})
+
+	out := "# HELP mysql_cluster_phase Cluster state as a number: " +
+		"0=Creating, 1=Ready, 2=Updating, 3=Degraded, 4=Failed.\n" +
+		"# TYPE mysql_cluster_phase gauge\n"
+	for _, key := range keys {
+		out += fmt.Sprintf("mysql_cluster_phase{namespace=%q,cluster=%q} %d\n",
+			key.namespace, key.name, phases[key])
+	}
+	return out
+}
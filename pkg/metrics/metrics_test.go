@@ -0,0 +1,53 @@
+package metrics_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/grtl/mysql-operator/pkg/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		metrics.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		body, err := ioutil.ReadAll(recorder.Result().Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	AfterEach(func() {
+		metrics.DeleteClusterPhase("default", "my-cluster")
+		metrics.DeleteClusterPhase("default", "other-cluster")
+	})
+
+	It("exports the cluster phase gauge", func() {
+		metrics.SetClusterPhase("default", "my-cluster", metrics.PhaseReady)
+		Expect(scrape()).To(ContainSubstring(
+			`mysql_cluster_phase{namespace="default",cluster="my-cluster"} 1`))
+	})
+
+	It("documents the phase mapping in the help text", func() {
+		Expect(scrape()).To(ContainSubstring(
+			"0=Creating, 1=Ready, 2=Updating, 3=Degraded, 4=Failed"))
+	})
+
+	It("sorts the series for stable scrapes", func() {
+		metrics.SetClusterPhase("default", "other-cluster", metrics.PhaseDegraded)
+		metrics.SetClusterPhase("default", "my-cluster", metrics.PhaseReady)
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("my-cluster"))
+		Expect(body).To(ContainSubstring("other-cluster"))
+		Expect(scrape()).To(Equal(body))
+	})
+
+	It("drops the series of a deleted cluster", func() {
+		metrics.SetClusterPhase("default", "my-cluster", metrics.PhaseFailed)
+		metrics.DeleteClusterPhase("default", "my-cluster")
+		Expect(scrape()).NotTo(ContainSubstring("my-cluster"))
+	})
+})
@@ -0,0 +1,32 @@
+package backupinstance
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+const jobCleanupTemplate = "artifacts/backupinstance-job-cleanup.yaml"
+
+// cleanupFailedBackup launches a job removing the partial artifact a failed
+// backup job may have left in storage, so it neither wastes space nor gets
+// restored from by accident. It returns a short outcome description for the
+// instance status.
+func cleanupFailedBackup(kubeClientset kubernetes.Interface, backup *crv1.MySQLBackupInstance) string {
+	job, err := jobForBackup(backup, jobCleanupTemplate)
+	if err != nil {
+		return fmt.Sprintf("cleanup not started: %v", err)
+	}
+
+	_, err = kubeClientset.BatchV1().Jobs(backup.Namespace).Create(job)
+	if apierrors.IsAlreadyExists(err) {
+		return "cleanup already started"
+	}
+	if err != nil {
+		return fmt.Sprintf("cleanup not started: %v", err)
+	}
+	return "cleanup started"
+}
@@ -31,3 +31,10 @@ func (c *FakeBackupInstanceOperator) DeleteBackup(backup *crv1.MySQLBackupInstan
 	// Just pretend we're deleting a cluster. Do nothing.
 	return c.err
 }
+
+// RecordBackupCompleted simulates stamping the backup completion time.
+// Returns error set via SetError.
+func (c *FakeBackupInstanceOperator) RecordBackupCompleted(backup *crv1.MySQLBackupInstance) error {
+	// Just pretend we're recording the completion. Do nothing.
+	return c.err
+}
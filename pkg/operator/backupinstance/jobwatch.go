@@ -0,0 +1,131 @@
+package backupinstance
+
+import (
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// jobWatchPollInterval is how often the create job is checked for completion.
+const jobWatchPollInterval = 15 * time.Second
+
+// jobWatchSlack extends the watch past the job's active deadline, so the
+// deadline firing is still observed.
+const jobWatchSlack = 10 * time.Minute
+
+// watchCreateJob waits for the backup create job to finish and folds its
+// outcome into the instance phase. The job script reports its own progress,
+// but a job killed from the outside - the active deadline in particular -
+// never gets to, and would otherwise leave the instance Started forever.
+func (b *backupInstanceOperator) watchCreateJob(backup *crv1.MySQLBackupInstance, activeDeadlineSeconds int64) {
+	jobInterface := b.kubeClientset.BatchV1().Jobs(backup.Namespace)
+	timeout := time.Duration(activeDeadlineSeconds)*time.Second + jobWatchSlack
+
+	var job *batchv1.Job
+	err := wait.PollImmediate(jobWatchPollInterval, timeout, func() (bool, error) {
+		var getErr error
+		job, getErr = jobInterface.Get(JobCreateName(backup.Name), metav1.GetOptions{})
+		if getErr != nil {
+			// A removed job has nothing left to report; any other error is
+			// worth retrying on the next poll.
+			return apierrors.IsNotFound(getErr), nil
+		}
+		return job.Status.Succeeded > 0 || backupJobFailed(job), nil
+	})
+	if err != nil {
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to watch the backup create job")
+		return
+	}
+	if job == nil {
+		return
+	}
+
+	err = b.syncPhaseFromJob(backup, job)
+	if err != nil {
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to record the backup job outcome")
+	}
+}
+
+// syncPhaseFromJob records the finished job's outcome on the backup instance,
+// unless the job script already reported a terminal phase itself.
+func (b *backupInstanceOperator) syncPhaseFromJob(backup *crv1.MySQLBackupInstance, job *batchv1.Job) error {
+	instanceInterface := b.clientset.CrV1().MySQLBackupInstances(backup.Namespace)
+	current, err := instanceInterface.Get(backup.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	switch current.Status.Phase {
+	case crv1.MySQLBackupCompleted, crv1.MySQLBackupFailed:
+		// The job script beat us to it.
+		return nil
+	}
+
+	if job.Status.Succeeded > 0 {
+		current.Status.Phase = crv1.MySQLBackupCompleted
+	} else {
+		current.Status.Phase = crv1.MySQLBackupFailed
+		current.Status.Message = "backup job failed"
+		eventReason := "BackupFailed"
+		if condition := backupJobFailedCondition(job); condition != nil {
+			if reason := backupJobFailureReason(job); reason != "" {
+				current.Status.Message = fmt.Sprintf("backup job failed: %s", reason)
+			}
+			// The Job controller sets both a Reason and a Message on a
+			// deadline kill - classify on the Reason, not the free-form text.
+			if condition.Reason == "DeadlineExceeded" {
+				// The active deadline cancelled a hung dump.
+				current.Status.Message = "backup job timed out"
+				eventReason = "BackupTimeout"
+			}
+		}
+		b.recordEvent(current, corev1.EventTypeWarning, eventReason, current.Status.Message)
+		// The failed job may have written a partial artifact - remove it so
+		// it cannot be restored from.
+		current.Status.CleanupStatus = cleanupFailedBackup(b.kubeClientset, current)
+	}
+
+	_, err = instanceInterface.Update(current)
+	return err
+}
+
+// recordEvent publishes an Event involving the backup instance, best effort.
+func (b *backupInstanceOperator) recordEvent(backup *crv1.MySQLBackupInstance, eventType, reason, message string) {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: backup.Name + "-",
+			Namespace:    backup.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:       "MySQLBackupInstance",
+			APIVersion: crv1.SchemeGroupVersion.String(),
+			Name:       backup.Name,
+			Namespace:  backup.Namespace,
+			UID:        backup.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         corev1.EventSource{Component: "mysql-operator"},
+	}
+
+	_, err := b.kubeClientset.CoreV1().Events(backup.Namespace).Create(event)
+	if err != nil {
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to record the event")
+	}
+}
@@ -0,0 +1,111 @@
+package backupinstance
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Job watch", func() {
+	var (
+		backup   *crv1.MySQLBackupInstance
+		operator *backupInstanceOperator
+		job      *batchv1.Job
+	)
+
+	currentPhase := func() crv1.MySQLBackupInstanceStatusPhase {
+		updated, err := operator.clientset.CrV1().
+			MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return updated.Status.Phase
+	}
+
+	BeforeEach(func() {
+		backup = new(crv1.MySQLBackupInstance)
+		err := factory.Build(testingFactory.MySQLBackupInstanceFactory).To(backup)
+		Expect(err).NotTo(HaveOccurred())
+		backup.Namespace = metav1.NamespaceDefault
+		backup.Status.Phase = crv1.MySQLBackupStarted
+
+		job = new(batchv1.Job)
+		job.Name = JobCreateName(backup.Name)
+		job.Namespace = metav1.NamespaceDefault
+
+		operator = &backupInstanceOperator{
+			clientset:     versioned.NewSimpleClientset(backup),
+			kubeClientset: fake.NewSimpleClientset(job),
+		}
+	})
+
+	It("completes the instance of a succeeded job", func() {
+		job.Status.Succeeded = 1
+
+		Expect(operator.syncPhaseFromJob(backup, job)).To(Succeed())
+		Expect(currentPhase()).To(Equal(crv1.MySQLBackupCompleted))
+	})
+
+	It("fails the instance of a failed job and starts the cleanup", func() {
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}
+
+		Expect(operator.syncPhaseFromJob(backup, job)).To(Succeed())
+
+		updated, err := operator.clientset.CrV1().
+			MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.Phase).To(Equal(crv1.MySQLBackupFailed))
+		Expect(updated.Status.Message).To(ContainSubstring("BackoffLimitExceeded"))
+		Expect(updated.Status.CleanupStatus).To(Equal("cleanup started"))
+	})
+
+	It("marks a job cancelled by its deadline as timed out", func() {
+		job.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "DeadlineExceeded",
+				Message: "Job was active longer than specified deadline",
+			},
+		}
+
+		Expect(operator.syncPhaseFromJob(backup, job)).To(Succeed())
+
+		updated, err := operator.clientset.CrV1().
+			MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.Phase).To(Equal(crv1.MySQLBackupFailed))
+		Expect(updated.Status.Message).To(ContainSubstring("timed out"))
+
+		events, err := operator.kubeClientset.CoreV1().
+			Events(metav1.NamespaceDefault).List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events.Items).To(HaveLen(1))
+		Expect(events.Items[0].Reason).To(Equal("BackupTimeout"))
+	})
+
+	It("leaves a phase the job script already reported alone", func() {
+		backup.Status.Phase = crv1.MySQLBackupFailed
+		backup.Status.Message = "upload to a required destination failed"
+		_, err := operator.clientset.CrV1().
+			MySQLBackupInstances(metav1.NamespaceDefault).Update(backup)
+		Expect(err).NotTo(HaveOccurred())
+		job.Status.Succeeded = 1
+
+		Expect(operator.syncPhaseFromJob(backup, job)).To(Succeed())
+		Expect(currentPhase()).To(Equal(crv1.MySQLBackupFailed))
+	})
+})
@@ -0,0 +1,33 @@
+package backupinstance
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// RecordBackupCompleted stamps the completion time of the given backup onto
+// its cluster's LastBackupTime, so backup freshness can be alerted on
+// straight off the cluster resource. The cluster is re-read and the update
+// retried on conflicts, so a concurrent reconcile cannot lose the stamp.
+func (b *backupInstanceOperator) RecordBackupCompleted(backup *crv1.MySQLBackupInstance) error {
+	completedAt := metav1.Now()
+
+	clusterInterface := b.clientset.CrV1().MySQLClusters(backup.Namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := clusterInterface.Get(backup.Spec.Cluster, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if cluster.Status.LastBackupTime != nil &&
+			completedAt.Before(cluster.Status.LastBackupTime) {
+			// A newer backup already stamped its completion.
+			return nil
+		}
+
+		cluster.Status.LastBackupTime = &completedAt
+		_, err = clusterInterface.Update(cluster)
+		return err
+	})
+}
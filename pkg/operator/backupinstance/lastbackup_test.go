@@ -0,0 +1,72 @@
+package backupinstance
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("RecordBackupCompleted", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		backup   *crv1.MySQLBackupInstance
+		operator *backupInstanceOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+
+		backup = new(crv1.MySQLBackupInstance)
+		err = factory.Build(testingFactory.MySQLBackupInstanceFactory).To(backup)
+		Expect(err).NotTo(HaveOccurred())
+		backup.Namespace = metav1.NamespaceDefault
+		backup.Spec.Cluster = cluster.Name
+
+		operator = &backupInstanceOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	It("stamps the cluster's last backup time", func() {
+		Expect(operator.RecordBackupCompleted(backup)).To(Succeed())
+
+		updated, err := operator.clientset.CrV1().
+			MySQLClusters(metav1.NamespaceDefault).Get(cluster.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.LastBackupTime).NotTo(BeNil())
+	})
+
+	It("keeps a newer stamp", func() {
+		future := metav1.NewTime(metav1.Now().Add(time.Hour))
+		cluster.Status.LastBackupTime = &future
+		_, err := operator.clientset.CrV1().
+			MySQLClusters(metav1.NamespaceDefault).Update(cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(operator.RecordBackupCompleted(backup)).To(Succeed())
+
+		updated, err := operator.clientset.CrV1().
+			MySQLClusters(metav1.NamespaceDefault).Get(cluster.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.LastBackupTime.Time).To(Equal(future.Time))
+	})
+
+	It("fails when the cluster does not exist", func() {
+		backup.Spec.Cluster = "no-such-cluster"
+		Expect(operator.RecordBackupCompleted(backup)).NotTo(Succeed())
+	})
+})
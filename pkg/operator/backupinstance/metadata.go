@@ -0,0 +1,95 @@
+package backupinstance
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// Labels stamped onto backup instances identifying their origin, so a
+// disaster recovery restore can locate backups by cluster and validate
+// compatibility before restoring.
+const (
+	SourceClusterLabel   = "cr.mysqloperator.grtl.github.com/source-cluster"
+	SourceNamespaceLabel = "cr.mysqloperator.grtl.github.com/source-namespace"
+	SourceVersionLabel   = "cr.mysqloperator.grtl.github.com/source-version"
+)
+
+// sourceMetadata identifies the origin of a backup. It is stamped onto the
+// backup instance and embedded into the uploaded artifact.
+type sourceMetadata struct {
+	Cluster   string
+	Namespace string
+	Version   string
+	Timestamp string
+}
+
+// stampSourceMetadata records the backup origin on the backup instance - as
+// labels for discovery and in the status for compatibility checks - and
+// returns it for embedding into the artifact.
+func (b *backupInstanceOperator) stampSourceMetadata(backup *crv1.MySQLBackupInstance, schedule *crv1.MySQLBackupSchedule) (sourceMetadata, error) {
+	clusterName := backup.Spec.Cluster
+	if clusterName == "" {
+		clusterName = schedule.Spec.Cluster
+	}
+	if clusterName == "" {
+		// Neither the backup nor its schedule names a cluster - there is
+		// nothing to stamp.
+		return sourceMetadata{}, nil
+	}
+
+	clustersInterface := b.clientset.CrV1().MySQLClusters(backup.Namespace)
+	backupCluster, err := clustersInterface.Get(clusterName, metav1.GetOptions{})
+	if err != nil {
+		// A missing cluster must not block the backup job - skip the
+		// stamping and carry on.
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to resolve the backup source cluster")
+		return sourceMetadata{}, nil
+	}
+
+	now := metav1.Now()
+	source := sourceMetadata{
+		Cluster:   backupCluster.Name,
+		Namespace: backupCluster.Namespace,
+		Version:   clusterVersion(backupCluster),
+		Timestamp: now.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+
+	if backup.Labels == nil {
+		backup.Labels = map[string]string{}
+	}
+	backup.Labels[SourceClusterLabel] = source.Cluster
+	backup.Labels[SourceNamespaceLabel] = source.Namespace
+	backup.Labels[SourceVersionLabel] = source.Version
+
+	backup.Status.SourceCluster = source.Cluster
+	backup.Status.SourceNamespace = source.Namespace
+	backup.Status.SourceVersion = source.Version
+	backup.Status.BackupTime = now
+
+	_, err = b.clientset.CrV1().MySQLBackupInstances(backup.Namespace).Update(backup)
+	if err != nil {
+		// The job creation matters more than the stamped metadata.
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to record the backup source metadata")
+	}
+	return source, nil
+}
+
+// clusterVersion returns the MySQL version of the given cluster, preferring
+// the version reported by the running primary over the image tag.
+func clusterVersion(cluster *crv1.MySQLCluster) string {
+	if cluster.Status.RunningVersion != "" {
+		return cluster.Status.RunningVersion
+	}
+
+	image := cluster.Spec.Image
+	if index := strings.LastIndex(image, ":"); index != -1 {
+		return image[index+1:]
+	}
+	return image
+}
@@ -0,0 +1,27 @@
+package backupinstance
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Metadata", func() {
+	Describe("clusterVersion", func() {
+		It("prefers the running version", func() {
+			cluster := &crv1.MySQLCluster{
+				Spec:   crv1.MySQLClusterSpec{Image: "mysql:5.7"},
+				Status: crv1.MySQLClusterStatus{RunningVersion: "5.7.44"},
+			}
+			Expect(clusterVersion(cluster)).To(Equal("5.7.44"))
+		})
+
+		It("falls back to the image tag", func() {
+			cluster := &crv1.MySQLCluster{
+				Spec: crv1.MySQLClusterSpec{Image: "mysql:8.0"},
+			}
+			Expect(clusterVersion(cluster)).To(Equal("8.0"))
+		})
+	})
+})
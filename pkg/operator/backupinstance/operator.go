@@ -1,26 +1,36 @@
 package backupinstance
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+
 	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
 	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/operator/cluster"
 	"github.com/grtl/mysql-operator/pkg/util"
 )
 
 const (
-	jobCreateTemplate = "artifacts/backupinstance-job-create.yaml"
-	jobDeleteTemplate = "artifacts/backupinstance-job-delete.yaml"
+	jobCreateTemplate   = "artifacts/backupinstance-job-create.yaml"
+	jobDeleteTemplate   = "artifacts/backupinstance-job-delete.yaml"
+	jobSnapshotTemplate = "artifacts/backupinstance-job-snapshot.yaml"
 )
 
 // Operator represents an object to manipulate Backup custom resources.
 type Operator interface {
 	CreateBackup(backup *crv1.MySQLBackupInstance) error
 	DeleteBackup(backup *crv1.MySQLBackupInstance) error
+	// RecordBackupCompleted stamps the backup's completion time onto its
+	// cluster's LastBackupTime.
+	RecordBackupCompleted(backup *crv1.MySQLBackupInstance) error
 }
 
 // NewBackupInstanceOperator returns a new Operator.
@@ -44,21 +54,77 @@ func (b *backupInstanceOperator) CreateBackup(backup *crv1.MySQLBackupInstance)
 
 	// Make sure the cluster schedule exists (for now we only create backups within a schedule)
 	schedulesInterface := b.clientset.CrV1().MySQLBackupSchedules(backup.Namespace)
-	_, err := schedulesInterface.Get(backup.Spec.Schedule, metav1.GetOptions{})
+	schedule, err := schedulesInterface.Get(backup.Spec.Schedule, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 
-	return b.createJobCreate(backup)
+	source, err := b.stampSourceMetadata(backup, schedule)
+	if err != nil {
+		return err
+	}
+
+	if backup.Spec.Method == crv1.BackupMethodSnapshot {
+		return b.createJobSnapshot(backup)
+	}
+
+	return b.createJobCreate(backup, schedule, source)
 }
 
 func (b *backupInstanceOperator) DeleteBackup(backup *crv1.MySQLBackupInstance) error {
 	return b.createJobDelete(backup)
 }
 
-func (b *backupInstanceOperator) createJobCreate(backup *crv1.MySQLBackupInstance) error {
+func (b *backupInstanceOperator) createJobCreate(backup *crv1.MySQLBackupInstance, schedule *crv1.MySQLBackupSchedule, source sourceMetadata) error {
+	sourceAddress, err := b.resolveSourceAddress(backup)
+	if err != nil {
+		return err
+	}
+
+	err = b.ensureDestinationSecrets(backup, schedule.Spec.Destinations)
+	if err != nil {
+		return err
+	}
+
+	err = validateFormat(schedule)
+	if err != nil {
+		return err
+	}
+
+	// The cluster secret is only ever embedded encrypted.
+	if schedule.Spec.IncludeSecret {
+		if schedule.Spec.SecretEncryptionKeyRef == nil {
+			return fmt.Errorf(
+				"schedule %q includes the cluster secret but specifies no encryption key",
+				schedule.Name)
+		}
+
+		err = b.ensureEncryptionKeySecret(backup, schedule.Spec.SecretEncryptionKeyRef)
+		if err != nil {
+			return err
+		}
+
+		b.recordEncryptionKey(backup, schedule.Spec.SecretEncryptionKeyRef)
+	}
+
+	// The embedded secret needs the secret name; the logical exports need
+	// its credentials to connect to the source.
+	clusterSecret := ""
+	if schedule.Spec.IncludeSecret || schedule.Spec.Format != "" {
+		backupCluster, err := b.clientset.CrV1().MySQLClusters(backup.Namespace).
+			Get(schedule.Spec.Cluster, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		clusterSecret = backupCluster.Spec.Secret
+	}
+
+	if schedule.Spec.Format != "" {
+		b.recordFormat(backup, schedule)
+	}
+
 	jobInterface := b.kubeClientset.BatchV1().Jobs(backup.Namespace)
-	job, err := jobForBackup(backup, jobCreateTemplate)
+	job, err := createJobForBackup(backup, sourceAddress, schedule, source, clusterSecret)
 	if err != nil {
 		return err
 	}
@@ -70,6 +136,8 @@ func (b *backupInstanceOperator) createJobCreate(backup *crv1.MySQLBackupInstanc
 		logging.LogBackupInstance(backup).Warn("Backup create job already exists")
 	}
 
+	go b.watchCreateJob(backup, jobActiveDeadlineSeconds(backup, schedule))
+
 	return nil
 }
 
@@ -90,8 +158,260 @@ func (b *backupInstanceOperator) createJobDelete(backup *crv1.MySQLBackupInstanc
 	return nil
 }
 
+// createJobSnapshot creates a job which quiesces a replica, takes a
+// VolumeSnapshot of its data volume and resumes replication afterwards.
+func (b *backupInstanceOperator) createJobSnapshot(backup *crv1.MySQLBackupInstance) error {
+	clustersInterface := b.clientset.CrV1().MySQLClusters(backup.Namespace)
+	backupCluster, err := clustersInterface.Get(backup.Spec.Cluster, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Quiescing the only member would block all traffic to the cluster.
+	if backupCluster.Spec.Replicas < 2 {
+		return fmt.Errorf(
+			"cannot take a snapshot backup of cluster %q: it has no replica to quiesce",
+			backup.Spec.Cluster)
+	}
+
+	podInterface := b.kubeClientset.CoreV1().Pods(backup.Namespace)
+	pods, err := podInterface.List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", backup.Spec.Cluster),
+	})
+	if err != nil {
+		return err
+	}
+
+	replica := leastLaggingReplica(pods.Items)
+	if replica == nil {
+		return fmt.Errorf(
+			"no ready replica available to snapshot cluster %q", backup.Spec.Cluster)
+	}
+
+	ordinal, err := cluster.PodOrdinal(replica.Name)
+	if err != nil {
+		return err
+	}
+
+	jobInterface := b.kubeClientset.BatchV1().Jobs(backup.Namespace)
+	job, err := snapshotJobForBackup(backup, backupCluster, ordinal)
+	if err != nil {
+		return err
+	}
+
+	_, err = jobInterface.Create(job)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	} else if apierrors.IsAlreadyExists(err) {
+		logging.LogBackupInstance(backup).Warn("Backup snapshot job already exists")
+	}
+
+	return nil
+}
+
+// resolveSourceAddress returns the address of the cluster member the backup
+// should be taken from, according to the backup source.
+func (b *backupInstanceOperator) resolveSourceAddress(backup *crv1.MySQLBackupInstance) (string, error) {
+	clusterName := backup.Spec.Cluster
+
+	// Fetch the cluster to resolve its (possibly prefixed/suffixed)
+	// resource name, which the pod and service names derive from.
+	resourceName := clusterName
+	backupCluster, err := b.clientset.CrV1().MySQLClusters(backup.Namespace).
+		Get(clusterName, metav1.GetOptions{})
+	if err == nil {
+		resourceName = backupCluster.ResourceName()
+	}
+
+	source := backup.Spec.Source
+	if source == "" || source == crv1.BackupSourcePrimary {
+		return cluster.PodAddress(resourceName, 0), nil
+	}
+
+	podInterface := b.kubeClientset.CoreV1().Pods(backup.Namespace)
+	pods, err := podInterface.List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", clusterName),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	replica := leastLaggingReplica(pods.Items)
+	if replica == nil {
+		if source == crv1.BackupSourceReplica {
+			return "", fmt.Errorf(
+				"no ready replica available to back up cluster %q", clusterName)
+		}
+		logging.LogBackupInstance(backup).Warn(
+			"No ready replica available, backing up the primary instead.")
+		return cluster.PodAddress(resourceName, 0), nil
+	}
+
+	return fmt.Sprintf("%s.%s", replica.Name, cluster.ServiceName(resourceName)), nil
+}
+
+// leastLaggingReplica returns the ready replica pod with the lowest
+// replication lag annotation, or nil when no ready replica exists. Pods
+// without the annotation are considered only when no annotated pod is ready.
+func leastLaggingReplica(pods []corev1.Pod) *corev1.Pod {
+	var best *corev1.Pod
+	var bestLag int64
+
+	for i := range pods {
+		pod := &pods[i]
+		ordinal, err := cluster.PodOrdinal(pod.Name)
+		if err != nil || ordinal == 0 {
+			// Skip the primary and pods not managed by a stateful set.
+			continue
+		}
+		if !cluster.IsPodReady(pod) {
+			continue
+		}
+
+		lag, err := replicationLag(pod)
+		if err != nil {
+			// Unknown lag - use only when nothing better is available.
+			lag = int64(^uint64(0) >> 1)
+		}
+
+		if best == nil || lag < bestLag {
+			best = pod
+			bestLag = lag
+		}
+	}
+
+	return best
+}
+
+func replicationLag(pod *corev1.Pod) (int64, error) {
+	lag, ok := pod.Annotations[cluster.ReplicationLagAnnotation]
+	if !ok {
+		return 0, fmt.Errorf("pod %q has no replication lag annotation", pod.Name)
+	}
+	return strconv.ParseInt(lag, 10, 64)
+}
+
 func jobForBackup(backup *crv1.MySQLBackupInstance, template string) (*batchv1.Job, error) {
 	job := new(batchv1.Job)
 	err := util.ObjectFromTemplate(backup, job, template, FuncMap)
 	return job, err
 }
+
+func snapshotJobForBackup(backup *crv1.MySQLBackupInstance, backupCluster *crv1.MySQLCluster, ordinal int) (*batchv1.Job, error) {
+	job := new(batchv1.Job)
+	err := util.ObjectFromTemplate(struct {
+		*crv1.MySQLBackupInstance
+		SourceAddress string
+		PVCName       string
+		Secret        string
+	}{
+		backup,
+		cluster.PodAddress(backupCluster.ResourceName(), ordinal),
+		cluster.DataPVCName(backupCluster.ResourceName(), ordinal),
+		backupCluster.Spec.Secret,
+	}, job, jobSnapshotTemplate, FuncMap)
+	return job, err
+}
+
+func createJobForBackup(backup *crv1.MySQLBackupInstance, sourceAddress string, schedule *crv1.MySQLBackupSchedule, source sourceMetadata, clusterSecret string) (*batchv1.Job, error) {
+	encryptionKeySecret := ""
+	if schedule.Spec.SecretEncryptionKeyRef != nil {
+		encryptionKeySecret = schedule.Spec.SecretEncryptionKeyRef.Name
+	}
+
+	job := new(batchv1.Job)
+	err := util.ObjectFromTemplate(struct {
+		*crv1.MySQLBackupInstance
+		SourceAddress         string
+		Destinations          []crv1.MySQLBackupDestination
+		Source                sourceMetadata
+		Resources             jobResources
+		IncludeSecret         bool
+		ClusterSecret         string
+		EncryptionKeySecret   string
+		Format                string
+		CSVTables             []string
+		BackoffLimit          int32
+		ActiveDeadlineSeconds int64
+	}{
+		backup,
+		sourceAddress,
+		schedule.Spec.Destinations,
+		source,
+		jobResourcesForSchedule(schedule),
+		schedule.Spec.IncludeSecret,
+		clusterSecret,
+		encryptionKeySecret,
+		schedule.Spec.Format,
+		schedule.Spec.CSVTables,
+		jobBackoffLimit(schedule),
+		jobActiveDeadlineSeconds(backup, schedule),
+	}, job, jobCreateTemplate, FuncMap)
+	return job, err
+}
+
+// Defaults for the backup job failure behavior when the schedule does not
+// set its own.
+const (
+	defaultJobBackoffLimit int32 = 3
+	// defaultJobActiveDeadlineSeconds bounds a hung backup at 6 hours.
+	defaultJobActiveDeadlineSeconds int64 = 6 * 60 * 60
+)
+
+func jobBackoffLimit(schedule *crv1.MySQLBackupSchedule) int32 {
+	if schedule.Spec.BackoffLimit != nil {
+		return *schedule.Spec.BackoffLimit
+	}
+	return defaultJobBackoffLimit
+}
+
+func jobActiveDeadlineSeconds(backup *crv1.MySQLBackupInstance, schedule *crv1.MySQLBackupSchedule) int64 {
+	if backup.Spec.BackupTimeoutSeconds != nil {
+		return *backup.Spec.BackupTimeoutSeconds
+	}
+	if schedule.Spec.ActiveDeadlineSeconds != nil {
+		return *schedule.Spec.ActiveDeadlineSeconds
+	}
+	return defaultJobActiveDeadlineSeconds
+}
+
+// tableNameFormat restricts CSV export table names to identifiers safe to
+// interpolate into the export commands.
+var tableNameFormat = regexp.MustCompile(`^[0-9A-Za-z_]+(\.[0-9A-Za-z_]+)?$`)
+
+// validateFormat checks the logical export format of the schedule.
+func validateFormat(schedule *crv1.MySQLBackupSchedule) error {
+	switch schedule.Spec.Format {
+	case "", crv1.BackupFormatSQL:
+	case crv1.BackupFormatCSV:
+		if len(schedule.Spec.CSVTables) == 0 {
+			return fmt.Errorf(
+				"schedule %q requests csv exports but lists no tables", schedule.Name)
+		}
+	default:
+		return fmt.Errorf(
+			"schedule %q has an unknown backup format %q, known formats are: %s, %s",
+			schedule.Name, schedule.Spec.Format,
+			crv1.BackupFormatSQL, crv1.BackupFormatCSV)
+	}
+
+	for _, table := range schedule.Spec.CSVTables {
+		if !tableNameFormat.MatchString(table) {
+			return fmt.Errorf(
+				"schedule %q has an invalid csv table name %q", schedule.Name, table)
+		}
+	}
+	return nil
+}
+
+// recordFormat records the logical export format and table list on the
+// instance status, best effort.
+func (b *backupInstanceOperator) recordFormat(backup *crv1.MySQLBackupInstance, schedule *crv1.MySQLBackupSchedule) {
+	backup.Status.Format = schedule.Spec.Format
+	backup.Status.ExportedTables = schedule.Spec.CSVTables
+	_, err := b.clientset.CrV1().MySQLBackupInstances(backup.Namespace).Update(backup)
+	if err != nil {
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to record the backup format")
+	}
+}
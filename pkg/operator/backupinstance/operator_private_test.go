@@ -5,10 +5,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/nauyey/factory"
 
 	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/operator/cluster"
 	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
 )
 
@@ -22,18 +24,158 @@ var _ = Describe("Operator Backup Instance Private", func() {
 		backup.Name = "my-backup-2018-04-27-01-21-03"
 	})
 
-	Describe("jobForBackup with the createJob template should generate a Create job", func() {
+	Describe("createJobForBackup should generate a Create job", func() {
 		var job *batchv1.Job
 
 		BeforeEach(func() {
 			var err error
-			job, err = jobForBackup(backup, jobCreateTemplate)
+			schedule := new(crv1.MySQLBackupSchedule)
+			job, err = createJobForBackup(
+				backup, "my-cluster-0.my-cluster", schedule, sourceMetadata{}, "")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
 		It("should have a name", func() {
 			Expect(job.Name).To(Equal("my-backup-2018-04-27-01-21-03-create"))
 		})
+
+		It("should clone from the source address", func() {
+			command := job.Spec.Template.Spec.Containers[0].Command
+			Expect(command[len(command)-1]).To(
+				ContainSubstring("my-cluster-0.my-cluster"))
+		})
+
+		It("should bound the job retries and runtime by default", func() {
+			Expect(*job.Spec.BackoffLimit).To(Equal(defaultJobBackoffLimit))
+			Expect(*job.Spec.ActiveDeadlineSeconds).To(
+				Equal(defaultJobActiveDeadlineSeconds))
+		})
+	})
+
+	Describe("createJobForBackup with a custom failure behavior", func() {
+		It("renders the schedule's backoff limit and deadline", func() {
+			backoffLimit := int32(1)
+			deadline := int64(600)
+			schedule := new(crv1.MySQLBackupSchedule)
+			schedule.Spec.BackoffLimit = &backoffLimit
+			schedule.Spec.ActiveDeadlineSeconds = &deadline
+
+			job, err := createJobForBackup(
+				backup, "my-cluster-0.my-cluster", schedule, sourceMetadata{}, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*job.Spec.BackoffLimit).To(Equal(backoffLimit))
+			Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(deadline))
+		})
+	})
+
+	Describe("createJobForBackup with a backup timeout", func() {
+		It("prefers the instance's timeout over the schedule's deadline", func() {
+			timeout := int64(300)
+			deadline := int64(600)
+			backup.Spec.BackupTimeoutSeconds = &timeout
+			schedule := new(crv1.MySQLBackupSchedule)
+			schedule.Spec.ActiveDeadlineSeconds = &deadline
+
+			job, err := createJobForBackup(
+				backup, "my-cluster-0.my-cluster", schedule, sourceMetadata{}, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(timeout))
+		})
+	})
+
+	Describe("createJobForBackup with a csv format", func() {
+		It("renders the per-table exports", func() {
+			schedule := new(crv1.MySQLBackupSchedule)
+			schedule.Spec.Format = crv1.BackupFormatCSV
+			schedule.Spec.CSVTables = []string{"shop.orders"}
+
+			job, err := createJobForBackup(
+				backup, "my-cluster-0.my-cluster", schedule, sourceMetadata{}, "my-secret")
+			Expect(err).NotTo(HaveOccurred())
+
+			command := job.Spec.Template.Spec.Containers[0].Command
+			Expect(command[len(command)-1]).To(
+				ContainSubstring("SELECT * FROM shop.orders"))
+		})
+	})
+
+	Describe("validateFormat", func() {
+		var schedule *crv1.MySQLBackupSchedule
+
+		BeforeEach(func() {
+			schedule = new(crv1.MySQLBackupSchedule)
+			schedule.Name = "my-schedule"
+		})
+
+		It("accepts the sql format", func() {
+			schedule.Spec.Format = crv1.BackupFormatSQL
+			Expect(validateFormat(schedule)).To(Succeed())
+		})
+
+		It("rejects an unknown format", func() {
+			schedule.Spec.Format = "parquet"
+			err := validateFormat(schedule)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown backup format"))
+		})
+
+		It("rejects csv without tables", func() {
+			schedule.Spec.Format = crv1.BackupFormatCSV
+			err := validateFormat(schedule)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("lists no tables"))
+		})
+
+		It("rejects an invalid table name", func() {
+			schedule.Spec.Format = crv1.BackupFormatCSV
+			schedule.Spec.CSVTables = []string{"shop.orders", "shop.orders; drop"}
+			err := validateFormat(schedule)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid csv table name"))
+		})
+	})
+
+	Describe("leastLaggingReplica", func() {
+		makePod := func(name string, ready bool, lag string) corev1.Pod {
+			pod := corev1.Pod{}
+			pod.Name = name
+			if lag != "" {
+				pod.Annotations = map[string]string{
+					cluster.ReplicationLagAnnotation: lag,
+				}
+			}
+			status := corev1.ConditionFalse
+			if ready {
+				status = corev1.ConditionTrue
+			}
+			pod.Status.Conditions = []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: status},
+			}
+			return pod
+		}
+
+		It("should return nil when no replica exists", func() {
+			pods := []corev1.Pod{makePod("my-cluster-0", true, "")}
+			Expect(leastLaggingReplica(pods)).To(BeNil())
+		})
+
+		It("should skip replicas which are not ready", func() {
+			pods := []corev1.Pod{
+				makePod("my-cluster-0", true, ""),
+				makePod("my-cluster-1", false, "0"),
+				makePod("my-cluster-2", true, "10"),
+			}
+			Expect(leastLaggingReplica(pods).Name).To(Equal("my-cluster-2"))
+		})
+
+		It("should pick the replica with the lowest lag", func() {
+			pods := []corev1.Pod{
+				makePod("my-cluster-1", true, "30"),
+				makePod("my-cluster-2", true, "5"),
+				makePod("my-cluster-3", true, ""),
+			}
+			Expect(leastLaggingReplica(pods).Name).To(Equal("my-cluster-2"))
+		})
 	})
 
 	Describe("jobForBackup with the deleteJob template should generate a Delete job", func() {
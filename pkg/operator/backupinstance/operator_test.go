@@ -82,6 +82,84 @@ var _ = Describe("Operator", func() {
 				job := jobs.Items[0]
 				Expect(job.Name).To(Equal(JobCreateName(backup.Name)))
 			})
+
+			It("stamps the backup with its source metadata", func() {
+				Expect(backup.Labels).To(HaveKeyWithValue(SourceClusterLabel, cluster.Name))
+				Expect(backup.Labels).To(HaveKeyWithValue(SourceNamespaceLabel, cluster.Namespace))
+				Expect(backup.Status.SourceCluster).To(Equal(cluster.Name))
+				Expect(backup.Status.SourceNamespace).To(Equal(cluster.Namespace))
+				Expect(backup.Status.BackupTime.IsZero()).To(BeFalse())
+			})
+		})
+
+		Describe("with a replica source and no ready replica", func() {
+			BeforeEach(func() {
+				backup = new(crv1.MySQLBackupInstance)
+				err := factory.Build(testingFactory.MySQLBackupInstanceFactory,
+					factory.WithField("ObjectMeta.Namespace", metav1.NamespaceDefault),
+					factory.WithField("Spec.Schedule", schedule.Name),
+					factory.WithField("Spec.Cluster", cluster.Name),
+					factory.WithField("Spec.Source", crv1.BackupSourceReplica)).To(backup)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = clientset.CrV1().MySQLClusters(cluster.Namespace).Create(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = clientset.CrV1().MySQLBackupSchedules(schedule.Namespace).Create(schedule)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails with an error", func() {
+				err := operator.CreateBackup(backup)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("with the snapshot method on a single-member cluster", func() {
+			BeforeEach(func() {
+				cluster.Spec.Replicas = 1
+
+				backup = new(crv1.MySQLBackupInstance)
+				err := factory.Build(testingFactory.MySQLBackupInstanceFactory,
+					factory.WithField("ObjectMeta.Namespace", metav1.NamespaceDefault),
+					factory.WithField("Spec.Schedule", schedule.Name),
+					factory.WithField("Spec.Cluster", cluster.Name),
+					factory.WithField("Spec.Method", crv1.BackupMethodSnapshot)).To(backup)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = clientset.CrV1().MySQLClusters(cluster.Namespace).Create(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = clientset.CrV1().MySQLBackupSchedules(schedule.Namespace).Create(schedule)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("refuses to quiesce the only member", func() {
+				err := operator.CreateBackup(backup)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("no replica to quiesce"))
+			})
+		})
+
+		Describe("including the cluster secret without an encryption key", func() {
+			BeforeEach(func() {
+				schedule.Spec.IncludeSecret = true
+
+				backup = new(crv1.MySQLBackupInstance)
+				err := factory.Build(testingFactory.MySQLBackupInstanceFactory,
+					factory.WithField("ObjectMeta.Namespace", metav1.NamespaceDefault),
+					factory.WithField("Spec.Schedule", schedule.Name)).To(backup)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = clientset.CrV1().MySQLClusters(cluster.Namespace).Create(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = clientset.CrV1().MySQLBackupSchedules(schedule.Namespace).Create(schedule)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails with an error", func() {
+				err := operator.CreateBackup(backup)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("encryption key"))
+			})
 		})
 
 		Describe("without an existing schedule", func() {
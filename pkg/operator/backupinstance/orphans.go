@@ -0,0 +1,128 @@
+package backupinstance
+
+import (
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// ReconcileOrphanedJobs picks up backup Jobs a previous operator instance
+// left behind: it matches the backup jobs in the namespace to their backup
+// instances, completes instance phases the jobs may have finished with while
+// no operator was watching, and removes succeeded create jobs. Failed jobs
+// are kept around for debugging, but the partial artifact they may have
+// written is cleaned up.
+func ReconcileOrphanedJobs(namespace string, clientset versioned.Interface, kubeClientset kubernetes.Interface) error {
+	jobInterface := kubeClientset.BatchV1().Jobs(namespace)
+	jobs, err := jobInterface.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	instanceInterface := clientset.CrV1().MySQLBackupInstances(namespace)
+	reconcileErrs := []error{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		backupName, ok := backupForJob(job.Name)
+		if !ok {
+			continue
+		}
+
+		backup, err := instanceInterface.Get(backupName, metav1.GetOptions{})
+		if err != nil {
+			// The job does not belong to a backup instance.
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			reconcileErrs = append(reconcileErrs, err)
+			continue
+		}
+
+		if job.Status.Succeeded > 0 {
+			if backup.Status.Phase != crv1.MySQLBackupCompleted {
+				backup.Status.Phase = crv1.MySQLBackupCompleted
+				_, err = instanceInterface.Update(backup)
+				if err != nil {
+					reconcileErrs = append(reconcileErrs, err)
+					continue
+				}
+				logging.LogBackupInstance(backup).Info(
+					"Completed the backup finished while the operator was down.")
+			}
+
+			// The outcome is reflected in the status - the job is done with.
+			err = jobInterface.Delete(job.Name, new(metav1.DeleteOptions))
+			if err != nil && !apierrors.IsNotFound(err) {
+				reconcileErrs = append(reconcileErrs, err)
+			}
+			continue
+		}
+
+		if backupJobFailed(job) && backup.Status.Phase != crv1.MySQLBackupFailed {
+			backup.Status.Phase = crv1.MySQLBackupFailed
+			backup.Status.Message = "backup job failed while the operator was down"
+			if reason := backupJobFailureReason(job); reason != "" {
+				backup.Status.Message = fmt.Sprintf("backup job failed: %s", reason)
+			}
+			// The failed job may have written a partial artifact - remove it
+			// so it cannot be restored from.
+			backup.Status.CleanupStatus = cleanupFailedBackup(kubeClientset, backup)
+			_, err = instanceInterface.Update(backup)
+			if err != nil {
+				reconcileErrs = append(reconcileErrs, err)
+			}
+		}
+	}
+
+	return errors.NewAggregate(reconcileErrs)
+}
+
+// backupForJob maps a backup job name back to its backup instance name, e.g.
+// my-backup-create to my-backup.
+func backupForJob(jobName string) (string, bool) {
+	for _, suffix := range []string{"-create", "-snapshot-job"} {
+		if strings.HasSuffix(jobName, suffix) {
+			return strings.TrimSuffix(jobName, suffix), true
+		}
+	}
+	return "", false
+}
+
+// backupJobFailedCondition returns the Failed condition of the Job, or nil
+// when the Job has not failed.
+func backupJobFailedCondition(job *batchv1.Job) *batchv1.JobCondition {
+	for i, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// backupJobFailed reports whether the Job has exhausted its retries.
+func backupJobFailed(job *batchv1.Job) bool {
+	return backupJobFailedCondition(job) != nil
+}
+
+// backupJobFailureReason returns the reason recorded on the Failed condition
+// of the Job, preferring its message when one is set.
+func backupJobFailureReason(job *batchv1.Job) string {
+	condition := backupJobFailedCondition(job)
+	if condition == nil {
+		return ""
+	}
+	if condition.Message != "" {
+		return condition.Message
+	}
+	return condition.Reason
+}
@@ -0,0 +1,118 @@
+package backupinstance
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Orphans", func() {
+	var (
+		backup    *crv1.MySQLBackupInstance
+		clientset *versioned.Clientset
+	)
+
+	jobNamed := func(name string) *batchv1.Job {
+		job := new(batchv1.Job)
+		job.Name = name
+		job.Namespace = metav1.NamespaceDefault
+		return job
+	}
+
+	BeforeEach(func() {
+		backup = new(crv1.MySQLBackupInstance)
+		err := factory.Build(testingFactory.MySQLBackupInstanceFactory).To(backup)
+		Expect(err).NotTo(HaveOccurred())
+		backup.Namespace = metav1.NamespaceDefault
+		backup.Status.Phase = crv1.MySQLBackupStarted
+
+		clientset = versioned.NewSimpleClientset(backup)
+	})
+
+	It("completes the instance of a succeeded job and removes the job", func() {
+		job := jobNamed(JobCreateName(backup.Name))
+		job.Status.Succeeded = 1
+		kubeClientset := fake.NewSimpleClientset(job)
+
+		Expect(ReconcileOrphanedJobs(
+			metav1.NamespaceDefault, clientset, kubeClientset)).To(Succeed())
+
+		updated, err := clientset.CrV1().MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.Phase).To(Equal(crv1.MySQLBackupCompleted))
+
+		_, err = kubeClientset.BatchV1().Jobs(metav1.NamespaceDefault).
+			Get(job.Name, metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails the instance of a failed job but keeps the job", func() {
+		job := jobNamed(JobCreateName(backup.Name))
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+		}
+		kubeClientset := fake.NewSimpleClientset(job)
+
+		Expect(ReconcileOrphanedJobs(
+			metav1.NamespaceDefault, clientset, kubeClientset)).To(Succeed())
+
+		updated, err := clientset.CrV1().MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.Phase).To(Equal(crv1.MySQLBackupFailed))
+
+		_, err = kubeClientset.BatchV1().Jobs(metav1.NamespaceDefault).
+			Get(job.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("cleans up the partial artifact of a failed job", func() {
+		job := jobNamed(JobCreateName(backup.Name))
+		job.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+				Reason: "BackoffLimitExceeded",
+			},
+		}
+		kubeClientset := fake.NewSimpleClientset(job)
+
+		Expect(ReconcileOrphanedJobs(
+			metav1.NamespaceDefault, clientset, kubeClientset)).To(Succeed())
+
+		updated, err := clientset.CrV1().MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.Message).To(ContainSubstring("BackoffLimitExceeded"))
+		Expect(updated.Status.CleanupStatus).To(Equal("cleanup started"))
+
+		_, err = kubeClientset.BatchV1().Jobs(metav1.NamespaceDefault).
+			Get(JobCleanupName(backup.Name), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("leaves running jobs and unrelated jobs alone", func() {
+		running := jobNamed(JobCreateName(backup.Name))
+		unrelated := jobNamed("somebody-elses-job")
+		kubeClientset := fake.NewSimpleClientset(running, unrelated)
+
+		Expect(ReconcileOrphanedJobs(
+			metav1.NamespaceDefault, clientset, kubeClientset)).To(Succeed())
+
+		updated, err := clientset.CrV1().MySQLBackupInstances(metav1.NamespaceDefault).
+			Get(backup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.Phase).To(Equal(crv1.MySQLBackupStarted))
+	})
+})
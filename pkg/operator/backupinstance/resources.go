@@ -0,0 +1,45 @@
+package backupinstance
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// Default resource requests of backup job pods.
+const (
+	DefaultJobCPURequest    = "100m"
+	DefaultJobMemoryRequest = "100Mi"
+)
+
+// jobResources holds the backup job resources in a template-friendly form.
+type jobResources struct {
+	Requests map[string]string
+	Limits   map[string]string
+}
+
+// jobResourcesForSchedule returns the schedule's job resources with the
+// default requests applied where none are configured.
+func jobResourcesForSchedule(schedule *crv1.MySQLBackupSchedule) jobResources {
+	resources := jobResources{
+		Requests: quantityStrings(schedule.Spec.Resources.Requests),
+		Limits:   quantityStrings(schedule.Spec.Resources.Limits),
+	}
+
+	if _, ok := resources.Requests[string(corev1.ResourceCPU)]; !ok {
+		resources.Requests[string(corev1.ResourceCPU)] = DefaultJobCPURequest
+	}
+	if _, ok := resources.Requests[string(corev1.ResourceMemory)]; !ok {
+		resources.Requests[string(corev1.ResourceMemory)] = DefaultJobMemoryRequest
+	}
+
+	return resources
+}
+
+func quantityStrings(list corev1.ResourceList) map[string]string {
+	strings := map[string]string{}
+	for name, quantity := range list {
+		strings[string(name)] = quantity.String()
+	}
+	return strings
+}
@@ -0,0 +1,41 @@
+package backupinstance
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Resources", func() {
+	It("applies default requests when none are configured", func() {
+		schedule := new(crv1.MySQLBackupSchedule)
+		resources := jobResourcesForSchedule(schedule)
+		Expect(resources.Requests).To(HaveKeyWithValue("cpu", DefaultJobCPURequest))
+		Expect(resources.Requests).To(HaveKeyWithValue("memory", DefaultJobMemoryRequest))
+		Expect(resources.Limits).To(BeEmpty())
+	})
+
+	It("keeps configured requests and limits", func() {
+		schedule := &crv1.MySQLBackupSchedule{
+			Spec: crv1.MySQLBackupScheduleSpec{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+				},
+			},
+		}
+
+		resources := jobResourcesForSchedule(schedule)
+		Expect(resources.Requests).To(HaveKeyWithValue("memory", "1Gi"))
+		Expect(resources.Requests).To(HaveKeyWithValue("cpu", DefaultJobCPURequest))
+		Expect(resources.Limits).To(HaveKeyWithValue("memory", "2Gi"))
+	})
+})
@@ -0,0 +1,120 @@
+package backupinstance
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/config"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// ensureDestinationSecrets makes the credential secrets of the given storage
+// destinations available in the backup's namespace. A cross-namespace
+// reference is only followed when its namespace is in the operator
+// allowlist; the secret is then copied next to the backup for the job to
+// use.
+func (b *backupInstanceOperator) ensureDestinationSecrets(backup *crv1.MySQLBackupInstance, destinations []crv1.MySQLBackupDestination) error {
+	for _, destination := range destinations {
+		if destination.Secret == nil {
+			continue
+		}
+
+		namespace := destination.Secret.Namespace
+		if namespace == "" || namespace == backup.Namespace {
+			continue
+		}
+
+		if !config.Get().SecretNamespaceAllowed(namespace) {
+			return fmt.Errorf(
+				"destination %q references a secret in namespace %q, "+
+					"which is not in the operator allowlist",
+				destination.Name, namespace)
+		}
+
+		err := b.copySecret(backup, destination.Secret.Name, namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureEncryptionKeySecret makes the secret encryption key available in the
+// backup's namespace, following the same allowlist rules as the destination
+// secrets.
+func (b *backupInstanceOperator) ensureEncryptionKeySecret(backup *crv1.MySQLBackupInstance, ref *crv1.MySQLBackupSecretRef) error {
+	namespace := ref.Namespace
+	if namespace == "" || namespace == backup.Namespace {
+		return nil
+	}
+
+	if !config.Get().SecretNamespaceAllowed(namespace) {
+		return fmt.Errorf(
+			"the encryption key secret is in namespace %q, "+
+				"which is not in the operator allowlist", namespace)
+	}
+
+	return b.copySecret(backup, ref.Name, namespace)
+}
+
+// recordEncryptionKey records on the backup which key its embedded cluster
+// secret was encrypted with, including the key secret's version at
+// encryption time. A restore reads the key reference back from the status,
+// so a later key rotation does not orphan the backup. Recording is best
+// effort - the backup itself proceeds regardless.
+func (b *backupInstanceOperator) recordEncryptionKey(backup *crv1.MySQLBackupInstance, ref *crv1.MySQLBackupSecretRef) {
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = backup.Namespace
+	}
+
+	secret, err := b.kubeClientset.CoreV1().Secrets(namespace).
+		Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to record the encryption key")
+		return
+	}
+
+	backup.Status.EncryptionKeySecret = ref.Name
+	backup.Status.EncryptionKeyVersion = secret.ResourceVersion
+
+	_, err = b.clientset.CrV1().MySQLBackupInstances(backup.Namespace).Update(backup)
+	if err != nil {
+		logging.LogBackupInstance(backup).WithField(
+			"fail", err).Warn("Unable to record the encryption key")
+	}
+}
+
+// copySecret copies the given secret into the backup's namespace, tolerating
+// an existing copy.
+func (b *backupInstanceOperator) copySecret(backup *crv1.MySQLBackupInstance, name, namespace string) error {
+	secret, err := b.kubeClientset.CoreV1().Secrets(namespace).
+		Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	localCopy := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secret.Name,
+			Namespace: backup.Namespace,
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+
+	_, err = b.kubeClientset.CoreV1().Secrets(backup.Namespace).Create(localCopy)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	} else if apierrors.IsAlreadyExists(err) {
+		logging.LogBackupInstance(backup).Warn("Secret already exists")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package backupinstance
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Destination Secrets", func() {
+	var (
+		backup   *crv1.MySQLBackupInstance
+		operator *backupInstanceOperator
+	)
+
+	BeforeEach(func() {
+		backup = new(crv1.MySQLBackupInstance)
+		err := factory.Build(testingFactory.MySQLBackupInstanceFactory).To(backup)
+		Expect(err).NotTo(HaveOccurred())
+
+		operator = &backupInstanceOperator{
+			clientset:     versioned.NewSimpleClientset(),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	It("accepts same-namespace secret references", func() {
+		destinations := []crv1.MySQLBackupDestination{
+			{Name: "primary", Secret: &crv1.MySQLBackupSecretRef{Name: "creds"}},
+		}
+		Expect(operator.ensureDestinationSecrets(backup, destinations)).To(Succeed())
+	})
+
+	It("refuses cross-namespace references outside the allowlist", func() {
+		destinations := []crv1.MySQLBackupDestination{
+			{Name: "primary", Secret: &crv1.MySQLBackupSecretRef{
+				Name:      "creds",
+				Namespace: "secrets",
+			}},
+		}
+
+		err := operator.ensureDestinationSecrets(backup, destinations)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("allowlist"))
+	})
+
+	Describe("recordEncryptionKey", func() {
+		It("records the key reference and version on the backup", func() {
+			keySecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "backup-key",
+					Namespace:       backup.Namespace,
+					ResourceVersion: "7",
+				},
+			}
+			operator.kubeClientset = fake.NewSimpleClientset(keySecret)
+			operator.clientset = versioned.NewSimpleClientset(backup)
+
+			operator.recordEncryptionKey(backup, &crv1.MySQLBackupSecretRef{Name: "backup-key"})
+			Expect(backup.Status.EncryptionKeySecret).To(Equal("backup-key"))
+			Expect(backup.Status.EncryptionKeyVersion).To(Equal("7"))
+		})
+
+		It("leaves the status alone when the key secret is missing", func() {
+			operator.recordEncryptionKey(backup, &crv1.MySQLBackupSecretRef{Name: "backup-key"})
+			Expect(backup.Status.EncryptionKeySecret).To(BeEmpty())
+		})
+	})
+})
@@ -10,9 +10,12 @@ import (
 // FuncMap can be used to execute templates with the helper functions from the
 // Backup Instance operator.
 var FuncMap = template.FuncMap{
-	"PVCName":       backupschedule.PVCName,
-	"JobCreateName": JobCreateName,
-	"JobDeleteName": JobDeleteName,
+	"PVCName":         backupschedule.PVCName,
+	"JobCreateName":   JobCreateName,
+	"JobDeleteName":   JobDeleteName,
+	"JobCleanupName":  JobCleanupName,
+	"JobSnapshotName": JobSnapshotName,
+	"SnapshotName":    SnapshotName,
 }
 
 // JobCreateName returns a "Create job" name for a given backup.
@@ -24,3 +27,19 @@ func JobCreateName(backupName string) string {
 func JobDeleteName(backupName string) string {
 	return fmt.Sprintf("%s-delete", backupName)
 }
+
+// JobCleanupName returns a "Cleanup job" name for a given backup.
+func JobCleanupName(backupName string) string {
+	return fmt.Sprintf("%s-cleanup", backupName)
+}
+
+// JobSnapshotName returns a "Snapshot job" name for a given backup.
+func JobSnapshotName(backupName string) string {
+	return fmt.Sprintf("%s-snapshot-job", backupName)
+}
+
+// SnapshotName returns a name for the VolumeSnapshot taken for a given
+// backup.
+func SnapshotName(backupName string) string {
+	return fmt.Sprintf("%s-snapshot", backupName)
+}
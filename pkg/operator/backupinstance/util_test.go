@@ -24,4 +24,18 @@ var _ = Describe("Util", func() {
 			Expect(JobDeleteName(anotherScheduleName)).To(Equal("another-backup-2018-27-04-10-31-03-delete"))
 		})
 	})
+
+	Describe("JobSnapshotName", func() {
+		It("should generate a name for the snapshot job", func() {
+			Expect(JobSnapshotName(scheduleName)).To(Equal("my-backup-2018-27-04-15-20-00-snapshot-job"))
+			Expect(JobSnapshotName(anotherScheduleName)).To(Equal("another-backup-2018-27-04-10-31-03-snapshot-job"))
+		})
+	})
+
+	Describe("SnapshotName", func() {
+		It("should generate a name for the volume snapshot", func() {
+			Expect(SnapshotName(scheduleName)).To(Equal("my-backup-2018-27-04-15-20-00-snapshot"))
+			Expect(SnapshotName(anotherScheduleName)).To(Equal("another-backup-2018-27-04-10-31-03-snapshot"))
+		})
+	})
 })
@@ -0,0 +1,66 @@
+package backupinstance
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+)
+
+// WaitForBackup blocks until the backup instance reaches a terminal phase or
+// the context is done. It returns the instance once its phase is Completed
+// and an error carrying the status message once its phase is Failed.
+func WaitForBackup(ctx context.Context, clientset versioned.Interface, namespace, name string) (*crv1.MySQLBackupInstance, error) {
+	backupInterface := clientset.CrV1().MySQLBackupInstances(namespace)
+
+	backup, err := backupInterface.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if terminal, err := backupResult(backup); terminal {
+		return backup, err
+	}
+
+	watcher, err := backupInterface.Watch(metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+		ResourceVersion: backup.ResourceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("watch on backup %q closed unexpectedly", name)
+			}
+			backup, ok := event.Object.(*crv1.MySQLBackupInstance)
+			if !ok || backup.Name != name {
+				continue
+			}
+			if terminal, err := backupResult(backup); terminal {
+				return backup, err
+			}
+		}
+	}
+}
+
+// backupResult reports whether the backup reached a terminal phase, and the
+// error to return for it.
+func backupResult(backup *crv1.MySQLBackupInstance) (bool, error) {
+	switch backup.Status.Phase {
+	case crv1.MySQLBackupCompleted:
+		return true, nil
+	case crv1.MySQLBackupFailed:
+		return true, fmt.Errorf("backup %q failed: %s", backup.Name, backup.Status.Message)
+	}
+	return false, nil
+}
@@ -0,0 +1,81 @@
+package backupinstance_test
+
+import (
+	. "github.com/grtl/mysql-operator/pkg/operator/backupinstance"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("WaitForBackup", func() {
+	var (
+		backup    *crv1.MySQLBackupInstance
+		clientset *versioned.Clientset
+	)
+
+	BeforeEach(func() {
+		backup = new(crv1.MySQLBackupInstance)
+		err := factory.Build(testingFactory.MySQLBackupInstanceFactory,
+			factory.WithField("ObjectMeta.Namespace", metav1.NamespaceDefault)).To(backup)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns immediately for a completed backup", func() {
+		backup.Status.Phase = crv1.MySQLBackupCompleted
+		clientset = versioned.NewSimpleClientset(backup)
+
+		result, err := WaitForBackup(
+			context.Background(), clientset, metav1.NamespaceDefault, backup.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status.Phase).To(Equal(crv1.MySQLBackupCompleted))
+	})
+
+	It("returns an error with the status message for a failed backup", func() {
+		backup.Status.Phase = crv1.MySQLBackupFailed
+		backup.Status.Message = "out of disk space"
+		clientset = versioned.NewSimpleClientset(backup)
+
+		_, err := WaitForBackup(
+			context.Background(), clientset, metav1.NamespaceDefault, backup.Name)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("out of disk space"))
+	})
+
+	It("returns once the backup completes", func() {
+		clientset = versioned.NewSimpleClientset(backup)
+
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(10 * time.Millisecond)
+			backup.Status.Phase = crv1.MySQLBackupCompleted
+			_, err := clientset.CrV1().MySQLBackupInstances(metav1.NamespaceDefault).
+				Update(backup)
+			Expect(err).NotTo(HaveOccurred())
+		}()
+
+		result, err := WaitForBackup(
+			context.Background(), clientset, metav1.NamespaceDefault, backup.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status.Phase).To(Equal(crv1.MySQLBackupCompleted))
+	})
+
+	It("gives up when the context is done", func() {
+		clientset = versioned.NewSimpleClientset(backup)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := WaitForBackup(ctx, clientset, metav1.NamespaceDefault, backup.Name)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+})
@@ -15,3 +15,13 @@ func (b *fakeBackupScheduleOperator) AddBackupSchedule(backup *crv1.MySQLBackupS
 	// Just pretend we're adding a new Backup Schedule. Do nothing.
 	return nil
 }
+
+func (b *fakeBackupScheduleOperator) UpdateBackupSchedule(schedule *crv1.MySQLBackupSchedule) error {
+	// Just pretend we're reconciling the schedule. Do nothing.
+	return nil
+}
+
+func (b *fakeBackupScheduleOperator) CheckStorage(schedule *crv1.MySQLBackupSchedule) error {
+	// Just pretend the storage is reachable. Do nothing.
+	return nil
+}
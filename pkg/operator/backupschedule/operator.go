@@ -23,6 +23,12 @@ const (
 // Operator represents an object to manipulate Backup custom resources.
 type Operator interface {
 	AddBackupSchedule(backup *crv1.MySQLBackupSchedule) error
+	// UpdateBackupSchedule reconciles the materialized CronJob toward the
+	// schedule spec, so e.g. a changed time takes effect.
+	UpdateBackupSchedule(schedule *crv1.MySQLBackupSchedule) error
+	// CheckStorage probes the schedule's storage destinations and records
+	// the reachability in the schedule status.
+	CheckStorage(schedule *crv1.MySQLBackupSchedule) error
 }
 
 type backupScheduleOperator struct {
@@ -39,6 +45,11 @@ func NewBackupScheduleOperator(clientset versioned.Interface, kubeClientset kube
 }
 
 func (b *backupScheduleOperator) AddBackupSchedule(schedule *crv1.MySQLBackupSchedule) error {
+	err := schedule.Validate()
+	if err != nil {
+		return err
+	}
+
 	clustersInterface := b.clientset.CrV1().MySQLClusters(schedule.Namespace)
 	cluster, err := clustersInterface.Get(schedule.Spec.Cluster, metav1.GetOptions{})
 	if err != nil {
@@ -101,6 +112,41 @@ func (b *backupScheduleOperator) createCronJob(schedule *crv1.MySQLBackupSchedul
 	return nil
 }
 
+// UpdateBackupSchedule reconciles the schedule's CronJob toward the spec.
+// The schedule is materialized as a native CronJob on purpose - it keeps
+// firing across operator restarts - so spec changes have to be carried over
+// to it. The CronJob is owned by the schedule and garbage collected with it.
+func (b *backupScheduleOperator) UpdateBackupSchedule(schedule *crv1.MySQLBackupSchedule) error {
+	err := schedule.Validate()
+	if err != nil {
+		return err
+	}
+
+	cronJobInterface := b.kubeClientset.BatchV1beta1().CronJobs(schedule.Namespace)
+	cronJob, err := cronJobForSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	live, err := cronJobInterface.Get(cronJob.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// The CronJob went missing - recreate it so the schedule keeps
+		// firing.
+		_, err = cronJobInterface.Create(cronJob)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	// Update only the fields the operator renders, preserving e.g. a suspend
+	// set by an administrator.
+	live.Spec.Schedule = cronJob.Spec.Schedule
+	live.Spec.JobTemplate = cronJob.Spec.JobTemplate
+	_, err = cronJobInterface.Update(live)
+	return err
+}
+
 func (b *backupScheduleOperator) removePVC(schedule *crv1.MySQLBackupSchedule) error {
 	pvcInterface := b.kubeClientset.CoreV1().Services(schedule.Namespace)
 	return pvcInterface.Delete(schedule.Name, new(metav1.DeleteOptions))
@@ -35,6 +35,20 @@ var _ = Describe("Operator Backup Schedule Private", func() {
 		It("should have a name", func() {
 			Expect(pvc.Name).To(Equal("my-schedule"))
 		})
+
+		It("should use the default storage class", func() {
+			Expect(pvc.Spec.StorageClassName).To(BeNil())
+		})
+	})
+
+	Describe("pvcForSchedule with a storage class", func() {
+		It("should render the storage class", func() {
+			schedule.Spec.StorageClassName = "fast-ssd"
+			pvc, err := pvcForSchedule(schedule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Spec.StorageClassName).NotTo(BeNil())
+			Expect(*pvc.Spec.StorageClassName).To(Equal("fast-ssd"))
+		})
 	})
 
 	Describe("cronJobForSchedule should generate a cronjob from the template", func() {
@@ -88,6 +88,58 @@ var _ = Describe("Operator", func() {
 		})
 	})
 
+	When("a Backup Schedule is updated", func() {
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			backup = new(crv1.MySQLBackupSchedule)
+			err = factory.Build(testingFactory.MySQLBackupScheduleFactory,
+				factory.WithField("Spec.Cluster", cluster.Name)).To(backup)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = clientset.CrV1().MySQLClusters(metav1.NamespaceDefault).Create(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(operator.AddBackupSchedule(backup)).To(Succeed())
+		})
+
+		It("carries a changed time over to the cron job", func() {
+			backup.Spec.Time = "0 4 * * *"
+			Expect(operator.UpdateBackupSchedule(backup)).To(Succeed())
+
+			cronJob, err := cronJobInterface.Get(CronJobName(backup.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronJob.Spec.Schedule).To(Equal("0 4 * * *"))
+		})
+
+		It("preserves a suspend set by an administrator", func() {
+			cronJob, err := cronJobInterface.Get(CronJobName(backup.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			suspend := true
+			cronJob.Spec.Suspend = &suspend
+			_, err = cronJobInterface.Update(cronJob)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(operator.UpdateBackupSchedule(backup)).To(Succeed())
+
+			cronJob, err = cronJobInterface.Get(CronJobName(backup.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronJob.Spec.Suspend).NotTo(BeNil())
+			Expect(*cronJob.Spec.Suspend).To(BeTrue())
+		})
+
+		It("recreates a missing cron job", func() {
+			err := cronJobInterface.Delete(CronJobName(backup.Name), new(metav1.DeleteOptions))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(operator.UpdateBackupSchedule(backup)).To(Succeed())
+
+			_, err = cronJobInterface.Get(CronJobName(backup.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 	When("a Backup Schedule without storage specified is added", func() {
 		BeforeEach(func() {
 			cluster = new(crv1.MySQLCluster)
@@ -0,0 +1,84 @@
+package backupschedule
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// storageProbeTimeout bounds a single destination probe.
+const storageProbeTimeout = 5 * time.Second
+
+// CheckStorage probes every storage destination of the schedule and records
+// the result in the schedule status. A change of reachability is logged, so
+// misconfigured credentials surface before a scheduled backup fails.
+func (b *backupScheduleOperator) CheckStorage(schedule *crv1.MySQLBackupSchedule) error {
+	reachable := true
+	message := ""
+	for _, destination := range schedule.Spec.Destinations {
+		if err := probeDestination(destination); err != nil {
+			reachable = false
+			message = fmt.Sprintf("destination %q unreachable: %v", destination.Name, err)
+			break
+		}
+	}
+
+	previous := schedule.Status.StorageReachable
+	if previous != nil && *previous == reachable && schedule.Status.StorageMessage == message {
+		return nil
+	}
+
+	if previous == nil || *previous != reachable {
+		logging.LogBackupSchedule(schedule).WithFields(logrus.Fields{
+			"reachable": reachable,
+			"message":   message,
+		}).Info("Storage reachability changed")
+	}
+
+	schedule.Status.StorageReachable = &reachable
+	schedule.Status.StorageMessage = message
+	_, err := b.clientset.CrV1().MySQLBackupSchedules(schedule.Namespace).Update(schedule)
+	return err
+}
+
+// probeDestination checks that the destination responds at all. HTTP(S)
+// destinations are probed with a HEAD request, anything else with a TCP
+// dial.
+func probeDestination(destination crv1.MySQLBackupDestination) error {
+	parsed, err := url.Parse(destination.URL)
+	if err != nil {
+		return err
+	}
+
+	switch parsed.Scheme {
+	case "http", "https":
+		client := &http.Client{Timeout: storageProbeTimeout}
+		response, err := client.Head(destination.URL)
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+		if response.StatusCode >= 500 {
+			return fmt.Errorf("storage responded with %s", response.Status)
+		}
+		return nil
+	default:
+		address := parsed.Host
+		if !strings.Contains(address, ":") {
+			address += ":443"
+		}
+		conn, err := net.DialTimeout("tcp", address, storageProbeTimeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
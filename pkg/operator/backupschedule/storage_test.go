@@ -0,0 +1,50 @@
+package backupschedule
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Storage", func() {
+	var (
+		schedule *crv1.MySQLBackupSchedule
+		operator *backupScheduleOperator
+	)
+
+	BeforeEach(func() {
+		schedule = new(crv1.MySQLBackupSchedule)
+		err := factory.Build(testingFactory.MySQLBackupScheduleFactory).To(schedule)
+		Expect(err).NotTo(HaveOccurred())
+
+		operator = &backupScheduleOperator{
+			clientset:     versioned.NewSimpleClientset(schedule),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	It("reports reachable for a schedule without destinations", func() {
+		Expect(operator.CheckStorage(schedule)).To(Succeed())
+		Expect(schedule.Status.StorageReachable).NotTo(BeNil())
+		Expect(*schedule.Status.StorageReachable).To(BeTrue())
+		Expect(schedule.Status.StorageMessage).To(BeEmpty())
+	})
+
+	It("reports unreachable destinations with a message", func() {
+		schedule.Spec.Destinations = []crv1.MySQLBackupDestination{
+			{Name: "primary", URL: "s3://no-such-bucket.invalid"},
+		}
+
+		Expect(operator.CheckStorage(schedule)).To(Succeed())
+		Expect(schedule.Status.StorageReachable).NotTo(BeNil())
+		Expect(*schedule.Status.StorageReachable).To(BeFalse())
+		Expect(schedule.Status.StorageMessage).To(ContainSubstring("primary"))
+	})
+})
@@ -0,0 +1,134 @@
+package cluster
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// AdoptAnnotation requests the adoption of a pre-existing, hand-managed
+// StatefulSet instead of creating a new one. Its value is the name of the
+// StatefulSet to adopt; the cluster services are adopted along with it when
+// they exist.
+const AdoptAnnotation = "cr.mysqloperator.grtl.github.com/adopt"
+
+// adoptionRequested returns the name of the StatefulSet the cluster asks to
+// adopt, if any.
+func adoptionRequested(cluster *crv1.MySQLCluster) (string, bool) {
+	name, ok := cluster.Annotations[AdoptAnnotation]
+	return name, ok && name != ""
+}
+
+// adoptResources brings the named hand-managed StatefulSet and the matching
+// services under operator management: after validating they are compatible
+// with the cluster spec, it stamps them with the operator labels and an
+// owner reference. The resources themselves are left running untouched.
+func (c *clusterOperator) adoptResources(cluster *crv1.MySQLCluster, name string) error {
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	statefulSet, err := statefulSetInterface.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	err = validateAdoption(cluster, statefulSet)
+	if err != nil {
+		return err
+	}
+
+	if adoptMeta(cluster, &statefulSet.ObjectMeta) {
+		_, err = statefulSetInterface.Update(statefulSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	serviceInterface := c.kubeClientset.CoreV1().Services(cluster.Namespace)
+	for _, serviceName := range []string{
+		ServiceName(cluster.ResourceName()),
+		ReadServiceName(cluster.ResourceName()),
+	} {
+		service, err := serviceInterface.Get(serviceName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// A missing service is created by the regular reconcile.
+				continue
+			}
+			return err
+		}
+		if adoptMeta(cluster, &service.ObjectMeta) {
+			_, err = serviceInterface.Update(service)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	logging.LogCluster(cluster).Info("Adopted the existing resources.")
+	return nil
+}
+
+// validateAdoption checks the hand-managed StatefulSet is compatible with
+// operator management before it is adopted.
+func validateAdoption(cluster *crv1.MySQLCluster, statefulSet *appsv1.StatefulSet) error {
+	if statefulSet.Name != StatefulSetName(cluster.ResourceName()) {
+		return fmt.Errorf(
+			"cannot adopt StatefulSet %q: its name does not match the cluster "+
+				"resource name %q, set spec.namePrefix/nameSuffix so the names line up",
+			statefulSet.Name, cluster.ResourceName())
+	}
+
+	if statefulSet.Spec.ServiceName != ServiceName(cluster.ResourceName()) {
+		return fmt.Errorf(
+			"cannot adopt StatefulSet %q: it is governed by service %q instead of %q",
+			statefulSet.Name, statefulSet.Spec.ServiceName, ServiceName(cluster.ResourceName()))
+	}
+
+	// The selector is immutable and the operator relies on it to find the
+	// cluster pods.
+	if statefulSet.Spec.Selector == nil ||
+		statefulSet.Spec.Selector.MatchLabels["app"] != cluster.Name {
+		return fmt.Errorf(
+			"cannot adopt StatefulSet %q: its selector does not select app=%s",
+			statefulSet.Name, cluster.Name)
+	}
+
+	for _, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name == "mysql" {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"cannot adopt StatefulSet %q: it has no mysql container", statefulSet.Name)
+}
+
+// adoptMeta stamps the object with the cluster app label and owner
+// reference. It reports whether the object changed.
+func adoptMeta(cluster *crv1.MySQLCluster, meta *metav1.ObjectMeta) bool {
+	changed := false
+
+	if meta.Labels["app"] != cluster.Name {
+		if meta.Labels == nil {
+			meta.Labels = map[string]string{}
+		}
+		meta.Labels["app"] = cluster.Name
+		changed = true
+	}
+
+	for _, owner := range meta.OwnerReferences {
+		if owner.UID == cluster.UID {
+			return changed
+		}
+	}
+	meta.OwnerReferences = append(meta.OwnerReferences, metav1.OwnerReference{
+		APIVersion: crv1.SchemeGroupVersion.String(),
+		Kind:       "MySQLCluster",
+		Name:       cluster.Name,
+		UID:        cluster.UID,
+	})
+	return true
+}
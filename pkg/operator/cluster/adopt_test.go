@@ -0,0 +1,115 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Adopt", func() {
+	var (
+		cluster     *crv1.MySQLCluster
+		statefulSet *appsv1.StatefulSet
+		operator    *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.WithDefaults()
+
+		statefulSet = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      StatefulSetName(cluster.Name),
+				Namespace: cluster.Namespace,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				ServiceName: ServiceName(cluster.Name),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": cluster.Name},
+				},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "mysql"}},
+					},
+				},
+			},
+		}
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(),
+			kubeClientset: fake.NewSimpleClientset(statefulSet),
+		}
+	})
+
+	Describe("adoptResources", func() {
+		It("stamps the StatefulSet with an owner reference", func() {
+			err := operator.adoptResources(cluster, statefulSet.Name)
+			Expect(err).NotTo(HaveOccurred())
+
+			adopted, err := operator.kubeClientset.AppsV1().
+				StatefulSets(cluster.Namespace).
+				Get(statefulSet.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adopted.Labels).To(HaveKeyWithValue("app", cluster.Name))
+			Expect(adopted.OwnerReferences).To(HaveLen(1))
+			Expect(adopted.OwnerReferences[0].Name).To(Equal(cluster.Name))
+		})
+
+		It("is idempotent", func() {
+			Expect(operator.adoptResources(cluster, statefulSet.Name)).To(Succeed())
+			Expect(operator.adoptResources(cluster, statefulSet.Name)).To(Succeed())
+
+			adopted, err := operator.kubeClientset.AppsV1().
+				StatefulSets(cluster.Namespace).
+				Get(statefulSet.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adopted.OwnerReferences).To(HaveLen(1))
+		})
+	})
+
+	Describe("validateAdoption", func() {
+		It("accepts a compatible StatefulSet", func() {
+			Expect(validateAdoption(cluster, statefulSet)).To(Succeed())
+		})
+
+		It("rejects a name not matching the cluster resource name", func() {
+			statefulSet.Name = "legacy-mysql"
+			err := validateAdoption(cluster, statefulSet)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("namePrefix"))
+		})
+
+		It("rejects a foreign governing service", func() {
+			statefulSet.Spec.ServiceName = "legacy-mysql"
+			err := validateAdoption(cluster, statefulSet)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("governed by service"))
+		})
+
+		It("rejects a selector not selecting the cluster pods", func() {
+			statefulSet.Spec.Selector.MatchLabels["app"] = "something-else"
+			err := validateAdoption(cluster, statefulSet)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("selector"))
+		})
+
+		It("rejects a pod template without a mysql container", func() {
+			statefulSet.Spec.Template.Spec.Containers = nil
+			err := validateAdoption(cluster, statefulSet)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no mysql container"))
+		})
+	})
+})
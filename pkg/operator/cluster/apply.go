@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FieldManager identifies the operator as the manager of the fields it
+// owns. It is recorded on managed objects via FieldManagerAnnotation so
+// other tools can tell which fields not to fight over.
+const FieldManager = "mysql-operator"
+
+// FieldManagerAnnotation marks an object as partially managed by the
+// operator. See applyStatefulSet and applyService for the owned fields.
+const FieldManagerAnnotation = "cr.mysqloperator.grtl.github.com/field-manager"
+
+// applyStatefulSet merges the operator-owned fields of the desired
+// StatefulSet into the live object and returns the result to update.
+// The operator claims ownership of spec.replicas, spec.template and
+// spec.updateStrategy; every other field - including labels and
+// annotations added by other tools - is preserved from the live object,
+// so the operator coexists with kubectl edits and GitOps controllers
+// instead of stomping them. MinReadySeconds postdates the vendored API
+// types and is applied with a raw patch instead - see
+// applyUnsupportedFields.
+func applyStatefulSet(live, desired *appsv1.StatefulSet) *appsv1.StatefulSet {
+	merged := live.DeepCopy()
+	merged.Spec.Replicas = desired.Spec.Replicas
+	merged.Spec.Template = desired.Spec.Template
+	merged.Spec.UpdateStrategy = desired.Spec.UpdateStrategy
+	stampFieldManager(&merged.ObjectMeta)
+	return merged
+}
+
+// applyService merges the operator-owned fields of the desired Service into
+// the live object and returns the result to update. The operator claims
+// ownership of spec.selector, spec.ports and the annotations it sets
+// itself; everything else - the allocated clusterIP in particular - is
+// preserved from the live object.
+func applyService(live, desired *corev1.Service) *corev1.Service {
+	merged := live.DeepCopy()
+	merged.Spec.Selector = desired.Spec.Selector
+	merged.Spec.Ports = desired.Spec.Ports
+	if desired.Spec.Type != "" {
+		// The operator only claims the type (and the pinned load balancer
+		// IP) when it renders one, so reconciles do not clear a type another
+		// tool set on the service.
+		merged.Spec.Type = desired.Spec.Type
+		merged.Spec.LoadBalancerIP = desired.Spec.LoadBalancerIP
+	}
+	for key, value := range desired.Annotations {
+		if merged.Annotations == nil {
+			merged.Annotations = map[string]string{}
+		}
+		merged.Annotations[key] = value
+	}
+	stampFieldManager(&merged.ObjectMeta)
+	return merged
+}
+
+// stampFieldManager records the operator as a field manager of the object
+// without touching any other metadata.
+func stampFieldManager(meta *metav1.ObjectMeta) {
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	meta.Annotations[FieldManagerAnnotation] = FieldManager
+}
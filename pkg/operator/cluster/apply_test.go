@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Apply", func() {
+	Describe("applyStatefulSet", func() {
+		var live, desired *appsv1.StatefulSet
+
+		BeforeEach(func() {
+			liveReplicas := int32(3)
+			live = &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "my-cluster",
+					ResourceVersion: "42",
+					Annotations:     map[string]string{"gitops.example.com/owner": "team-a"},
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:            &liveReplicas,
+					PodManagementPolicy: appsv1.ParallelPodManagement,
+				},
+			}
+
+			desiredReplicas := int32(5)
+			desired = &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: &desiredReplicas,
+				},
+			}
+		})
+
+		It("overrides only the operator-owned fields", func() {
+			merged := applyStatefulSet(live, desired)
+			Expect(*merged.Spec.Replicas).To(Equal(int32(5)))
+			Expect(merged.Spec.PodManagementPolicy).To(BeEquivalentTo(appsv1.ParallelPodManagement))
+			Expect(merged.ResourceVersion).To(Equal("42"))
+		})
+
+		It("preserves annotations set by other tools", func() {
+			merged := applyStatefulSet(live, desired)
+			Expect(merged.Annotations).To(HaveKeyWithValue("gitops.example.com/owner", "team-a"))
+			Expect(merged.Annotations).To(HaveKeyWithValue(FieldManagerAnnotation, FieldManager))
+		})
+	})
+
+	Describe("applyService", func() {
+		It("preserves the allocated cluster IP", func() {
+			live := &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					ClusterIP: "10.0.0.7",
+					Selector:  map[string]string{"app": "my-cluster", "role": "replica"},
+				},
+			}
+			desired := &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "my-cluster"},
+				},
+			}
+
+			merged := applyService(live, desired)
+			Expect(merged.Spec.ClusterIP).To(Equal("10.0.0.7"))
+			Expect(merged.Spec.Selector).NotTo(HaveKey("role"))
+		})
+
+		It("keeps the pinned load balancer IP on reconcile", func() {
+			live := &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:           corev1.ServiceTypeLoadBalancer,
+					LoadBalancerIP: "192.0.2.10",
+				},
+			}
+			desired := &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:           corev1.ServiceTypeLoadBalancer,
+					LoadBalancerIP: "192.0.2.10",
+				},
+			}
+
+			merged := applyService(live, desired)
+			Expect(merged.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(merged.Spec.LoadBalancerIP).To(Equal("192.0.2.10"))
+		})
+
+		It("leaves a type set by other tools alone", func() {
+			live := &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort},
+			}
+			desired := new(corev1.Service)
+
+			merged := applyService(live, desired)
+			Expect(merged.Spec.Type).To(Equal(corev1.ServiceTypeNodePort))
+		})
+	})
+})
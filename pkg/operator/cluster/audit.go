@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// recordAudit appends a reconcile action to the cluster's status audit log,
+// keeping only the most recent entries, and publishes it as a normal Event.
+// The audit trail is best effort - a failure to record it never fails the
+// reconcile itself.
+func (c *clusterOperator) recordAudit(cluster *crv1.MySQLCluster, action, summary string) {
+	c.recordEvent(cluster, corev1.EventTypeNormal, action, summary)
+
+	cluster.Status.AuditLog = appendAuditEntry(cluster.Status.AuditLog, action, summary)
+	_, err := c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	if err != nil {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Unable to record the audit entry")
+	}
+}
+
+// appendAuditEntry appends an entry to the audit log and trims it to the
+// last MaxAuditLogEntries entries.
+func appendAuditEntry(log []crv1.MySQLClusterAuditEntry, action, summary string) []crv1.MySQLClusterAuditEntry {
+	log = append(log, crv1.MySQLClusterAuditEntry{
+		Time:    metav1.Now(),
+		Action:  action,
+		Summary: summary,
+	})
+	if len(log) > crv1.MaxAuditLogEntries {
+		log = log[len(log)-crv1.MaxAuditLogEntries:]
+	}
+	return log
+}
+
+// updateSummary describes what an update changed on the StatefulSet as
+// before/after values, comparing the cluster spec against the previously
+// live object. An empty summary means nothing relevant changed.
+func updateSummary(live *appsv1.StatefulSet, cluster *crv1.MySQLCluster) string {
+	if live == nil {
+		return "StatefulSet created"
+	}
+
+	summary := ""
+	if replicas := replicasOrDefault(live); replicas != cluster.Spec.Replicas {
+		summary += fmt.Sprintf("replicas: %d -> %d; ", replicas, cluster.Spec.Replicas)
+	}
+	if image := liveMySQLImage(live); image != "" && image != cluster.Spec.Image {
+		summary += fmt.Sprintf("image: %s -> %s; ", image, cluster.Spec.Image)
+	}
+	if summary == "" {
+		return ""
+	}
+	return summary[:len(summary)-len("; ")]
+}
+
+// liveMySQLImage returns the image of the mysql container, or "" when the
+// pod template has none.
+func liveMySQLImage(statefulSet *appsv1.StatefulSet) string {
+	for _, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name == "mysql" {
+			return container.Image
+		}
+	}
+	return ""
+}
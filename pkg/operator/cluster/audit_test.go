@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Audit", func() {
+	Describe("appendAuditEntry", func() {
+		It("keeps only the most recent entries", func() {
+			var log []crv1.MySQLClusterAuditEntry
+			for i := 0; i < crv1.MaxAuditLogEntries+5; i++ {
+				log = appendAuditEntry(log, "Update", "change")
+			}
+			Expect(log).To(HaveLen(crv1.MaxAuditLogEntries))
+		})
+
+		It("appends newest last", func() {
+			log := appendAuditEntry(nil, "Create", "created")
+			log = appendAuditEntry(log, "Update", "changed")
+			Expect(log[len(log)-1].Action).To(Equal("Update"))
+			Expect(log[0].Time.IsZero()).To(BeFalse())
+		})
+	})
+
+	Describe("updateSummary", func() {
+		var cluster *crv1.MySQLCluster
+
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			cluster.WithDefaults()
+		})
+
+		It("reports a replica count change with before and after", func() {
+			liveReplicas := cluster.Spec.Replicas + 1
+			live := &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{Replicas: &liveReplicas},
+			}
+			summary := updateSummary(live, cluster)
+			Expect(summary).To(ContainSubstring("replicas:"))
+			Expect(summary).To(ContainSubstring("->"))
+		})
+
+		It("is empty when nothing relevant changed", func() {
+			liveReplicas := cluster.Spec.Replicas
+			live := &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: &liveReplicas,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: "mysql", Image: cluster.Spec.Image},
+							},
+						},
+					},
+				},
+			}
+			Expect(updateSummary(live, cluster)).To(BeEmpty())
+		})
+	})
+
+	Describe("recordAudit", func() {
+		It("records the entry in status and publishes an event", func() {
+			cluster := new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			operator := &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(cluster),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+
+			operator.recordAudit(cluster, "Create", "Cluster resources created")
+			Expect(cluster.Status.AuditLog).To(HaveLen(1))
+			Expect(cluster.Status.AuditLog[0].Action).To(Equal("Create"))
+
+			events, err := operator.kubeClientset.CoreV1().
+				Events(cluster.Namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events.Items).To(HaveLen(1))
+			Expect(events.Items[0].Type).To(Equal(corev1.EventTypeNormal))
+		})
+	})
+})
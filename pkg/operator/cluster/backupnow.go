@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// BackupNowAnnotation triggers an immediate backup of the cluster. Its value
+// is an arbitrary timestamp - a backup is created whenever the value differs
+// from the last one handled, which is recorded in the cluster status.
+const BackupNowAnnotation = "mysqloperator.grtl.github.com/backup-now"
+
+// handleBackupNow creates a backup instance for an unhandled backup-now
+// annotation and records the handled value in the cluster status.
+func (c *clusterOperator) handleBackupNow(cluster *crv1.MySQLCluster) error {
+	timestamp := cluster.Annotations[BackupNowAnnotation]
+	if timestamp == "" || timestamp == cluster.Status.BackupNowHandled {
+		return nil
+	}
+
+	schedule, err := c.scheduleForCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	backup := &crv1.MySQLBackupInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupNowName(cluster.Name, timestamp),
+			Namespace: cluster.Namespace,
+		},
+		Spec: crv1.MySQLBackupInstanceSpec{
+			Schedule: schedule.Name,
+			Cluster:  cluster.Name,
+		},
+		Status: crv1.MySQLBackupInstanceStatus{
+			Phase: crv1.MySQLBackupScheduled,
+		},
+	}
+
+	backupInterface := c.clientset.CrV1().MySQLBackupInstances(cluster.Namespace)
+	_, err = backupInterface.Create(backup)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	} else if apierrors.IsAlreadyExists(err) {
+		logging.LogCluster(cluster).Warn("Backup instance for backup-now already exists")
+	}
+
+	cluster.Status.BackupNowHandled = timestamp
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// scheduleForCluster returns a backup schedule of the cluster. Immediate
+// backups reuse the schedule's backup volume.
+func (c *clusterOperator) scheduleForCluster(cluster *crv1.MySQLCluster) (*crv1.MySQLBackupSchedule, error) {
+	schedulesInterface := c.clientset.CrV1().MySQLBackupSchedules(cluster.Namespace)
+	schedules, err := schedulesInterface.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range schedules.Items {
+		schedule := &schedules.Items[i]
+		if schedule.Spec.Cluster == cluster.Name {
+			return schedule, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"cannot back up cluster %q now: it has no backup schedule to store the backup in",
+		cluster.Name)
+}
+
+// backupNowName derives a deterministic backup instance name from the
+// annotation value, so retries with the same value stay idempotent.
+func backupNowName(clusterName, timestamp string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return '-'
+		}
+	}, timestamp)
+	return fmt.Sprintf("%s-backup-%s", clusterName, sanitized)
+}
@@ -0,0 +1,82 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Backup Now", func() {
+	Describe("backupNowName", func() {
+		It("sanitizes the annotation value into a valid name", func() {
+			Expect(backupNowName("my-cluster", "2018-06-01T12:00:00Z")).To(
+				Equal("my-cluster-backup-2018-06-01t12-00-00z"))
+		})
+	})
+
+	Describe("handleBackupNow", func() {
+		var (
+			cluster  *crv1.MySQLCluster
+			schedule *crv1.MySQLBackupSchedule
+			operator *clusterOperator
+		)
+
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			schedule = new(crv1.MySQLBackupSchedule)
+			err = factory.Build(testingFactory.MySQLBackupScheduleFactory,
+				factory.WithField("ObjectMeta.Namespace", cluster.Namespace),
+				factory.WithField("Spec.Cluster", cluster.Name)).To(schedule)
+			Expect(err).NotTo(HaveOccurred())
+
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(cluster, schedule),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+		})
+
+		It("does nothing without the annotation", func() {
+			Expect(operator.handleBackupNow(cluster)).To(Succeed())
+
+			backups, err := operator.clientset.CrV1().
+				MySQLBackupInstances(cluster.Namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backups.Items).To(BeEmpty())
+		})
+
+		It("creates a backup instance and records the handled value", func() {
+			cluster.Annotations = map[string]string{BackupNowAnnotation: "now"}
+			Expect(operator.handleBackupNow(cluster)).To(Succeed())
+			Expect(cluster.Status.BackupNowHandled).To(Equal("now"))
+
+			backup, err := operator.clientset.CrV1().
+				MySQLBackupInstances(cluster.Namespace).
+				Get(backupNowName(cluster.Name, "now"), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backup.Spec.Cluster).To(Equal(cluster.Name))
+			Expect(backup.Spec.Schedule).To(Equal(schedule.Name))
+		})
+
+		It("does not create a second backup for a handled value", func() {
+			cluster.Annotations = map[string]string{BackupNowAnnotation: "now"}
+			Expect(operator.handleBackupNow(cluster)).To(Succeed())
+			Expect(operator.handleBackupNow(cluster)).To(Succeed())
+
+			backups, err := operator.clientset.CrV1().
+				MySQLBackupInstances(cluster.Namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backups.Items).To(HaveLen(1))
+		})
+	})
+})
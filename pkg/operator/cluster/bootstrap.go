@@ -0,0 +1,90 @@
+package cluster
+
+import (
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// bootstrapPollInterval is how often the bootstrap watchdog checks whether
+// the cluster has become ready.
+const bootstrapPollInterval = 10 * time.Second
+
+// watchBootstrap waits for a newly created cluster to become ready within
+// its bootstrap timeout. On a timeout the cluster is marked BootstrapFailed
+// and, when opted in, the created resources are rolled back.
+func (c *clusterOperator) watchBootstrap(cluster *crv1.MySQLCluster) {
+	timeout := time.Duration(cluster.Spec.BootstrapTimeoutSeconds) * time.Second
+
+	err := wait.PollImmediate(bootstrapPollInterval, timeout, func() (bool, error) {
+		return c.bootstrapComplete(cluster)
+	})
+	if err == nil {
+		return
+	}
+	if err != wait.ErrWaitTimeout {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Unable to watch the cluster bootstrap")
+		return
+	}
+
+	message := fmt.Sprintf(
+		"Cluster did not become ready within %s", timeout)
+	logging.LogCluster(cluster).Warn(message)
+
+	if cluster.Spec.RollbackOnBootstrapFailure {
+		rollbackErr := c.rollbackBootstrap(cluster)
+		if rollbackErr != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", rollbackErr).Warn("Unable to roll back the bootstrap")
+		} else {
+			message += "; the created resources were rolled back"
+		}
+	}
+
+	err = c.setClusterState(cluster, "BootstrapFailed", message)
+	if err != nil {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Setting status")
+	}
+}
+
+// bootstrapComplete returns true when every requested cluster member is
+// ready.
+func (c *clusterOperator) bootstrapComplete(cluster *crv1.MySQLCluster) (bool, error) {
+	statefulSet, err := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace).
+		Get(StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return statefulSet.Status.ReadyReplicas >= cluster.Spec.Replicas, nil
+}
+
+// rollbackBootstrap deletes the resources created for the cluster, so a
+// misconfigured cluster doesn't sit around broken.
+func (c *clusterOperator) rollbackBootstrap(cluster *crv1.MySQLCluster) error {
+	deleteErrs := []error{
+		c.removeStatefulSet(cluster),
+		c.removeService(cluster),
+		c.removeReadService(cluster),
+	}
+
+	allowedErrs := make([]error, 0, len(deleteErrs))
+	for _, err := range deleteErrs {
+		if err != nil && !apierrors.IsNotFound(err) {
+			allowedErrs = append(allowedErrs, err)
+		}
+	}
+
+	return errors.NewAggregate(allowedErrs)
+}
@@ -0,0 +1,89 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Bootstrap", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Spec.Replicas = 2
+	})
+
+	statefulSetWithReadyReplicas := func(ready int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      StatefulSetName(cluster.Name),
+				Namespace: cluster.Namespace,
+			},
+			Status: appsv1.StatefulSetStatus{ReadyReplicas: ready},
+		}
+	}
+
+	Describe("bootstrapComplete", func() {
+		It("reports an unready cluster as incomplete", func() {
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(statefulSetWithReadyReplicas(1)),
+			}
+			Expect(operator.bootstrapComplete(cluster)).To(BeFalse())
+		})
+
+		It("reports a fully ready cluster as complete", func() {
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(statefulSetWithReadyReplicas(2)),
+			}
+			Expect(operator.bootstrapComplete(cluster)).To(BeTrue())
+		})
+
+		It("reports a missing stateful set as incomplete", func() {
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+			Expect(operator.bootstrapComplete(cluster)).To(BeFalse())
+		})
+	})
+
+	Describe("rollbackBootstrap", func() {
+		It("removes the created resources", func() {
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(statefulSetWithReadyReplicas(0)),
+			}
+			Expect(operator.rollbackBootstrap(cluster)).To(Succeed())
+
+			statefulSets, err := operator.kubeClientset.AppsV1().
+				StatefulSets(cluster.Namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSets.Items).To(BeEmpty())
+		})
+
+		It("tolerates already removed resources", func() {
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+			Expect(operator.rollbackBootstrap(cluster)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// checkRestoreCompatibility refuses restoring a backup taken from a newer
+// MySQL than the target cluster runs, which would corrupt the data. It uses
+// the source version stamped on the backup instance; backups without it are
+// let through, as are clusters with ForceRestore set.
+func (c *clusterOperator) checkRestoreCompatibility(cluster *crv1.MySQLCluster, backup *crv1.MySQLBackupInstance) error {
+	if cluster.Spec.ForceRestore {
+		logging.LogCluster(cluster).Warn(
+			"Restore compatibility check skipped via forceRestore.")
+		return nil
+	}
+
+	source := backup.Status.SourceVersion
+	target := imageVersion(cluster.Spec.Image)
+	if source == "" || target == "" {
+		return nil
+	}
+
+	newer, err := versionNewer(source, target)
+	if err != nil {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Unable to compare the backup and cluster versions")
+		return nil
+	}
+
+	if newer {
+		return fmt.Errorf(
+			"backup %q was taken from MySQL %s, which is newer than the target version %s; "+
+				"set forceRestore to restore anyway", backup.Name, source, target)
+	}
+
+	return nil
+}
+
+// imageVersion returns the version tag of the given image, or "" when the
+// image has no tag.
+func imageVersion(image string) string {
+	index := strings.LastIndex(image, ":")
+	if index == -1 {
+		return ""
+	}
+	return image[index+1:]
+}
+
+// versionNewer returns true when version a is newer than version b,
+// comparing the numeric dotted parts.
+func versionNewer(a, b string) (bool, error) {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numberA, err := strconv.Atoi(partsA[i])
+		if err != nil {
+			return false, fmt.Errorf("invalid version %q", a)
+		}
+		numberB, err := strconv.Atoi(partsB[i])
+		if err != nil {
+			return false, fmt.Errorf("invalid version %q", b)
+		}
+
+		if numberA != numberB {
+			return numberA > numberB, nil
+		}
+	}
+
+	return len(partsA) > len(partsB), nil
+}
@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Compatibility", func() {
+	Describe("versionNewer", func() {
+		It("compares dotted versions numerically", func() {
+			Expect(versionNewer("8.0", "5.7")).To(BeTrue())
+			Expect(versionNewer("5.7", "8.0")).To(BeFalse())
+			Expect(versionNewer("8.0.32", "8.0")).To(BeTrue())
+			Expect(versionNewer("8.0", "8.0")).To(BeFalse())
+		})
+
+		It("fails on non-numeric versions", func() {
+			_, err := versionNewer("latest", "8.0")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("checkRestoreCompatibility", func() {
+		var (
+			cluster *crv1.MySQLCluster
+			backup  *crv1.MySQLBackupInstance
+		)
+
+		operator := &clusterOperator{}
+
+		BeforeEach(func() {
+			cluster = &crv1.MySQLCluster{
+				Spec: crv1.MySQLClusterSpec{Image: "mysql:5.7"},
+			}
+			backup = &crv1.MySQLBackupInstance{
+				Status: crv1.MySQLBackupInstanceStatus{SourceVersion: "8.0"},
+			}
+		})
+
+		It("refuses restoring a newer backup", func() {
+			err := operator.checkRestoreCompatibility(cluster, backup)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("newer than the target"))
+		})
+
+		It("allows restoring an older or equal backup", func() {
+			backup.Status.SourceVersion = "5.7"
+			Expect(operator.checkRestoreCompatibility(cluster, backup)).To(Succeed())
+		})
+
+		It("allows backups without a recorded source version", func() {
+			backup.Status.SourceVersion = ""
+			Expect(operator.checkRestoreCompatibility(cluster, backup)).To(Succeed())
+		})
+
+		It("can be overridden via forceRestore", func() {
+			cluster.Spec.ForceRestore = true
+			Expect(operator.checkRestoreCompatibility(cluster, backup)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/util"
+)
+
+// checkCustomConfig verifies the config map the cluster's custom server
+// configuration references actually exists, so a typo is reported at
+// reconcile time instead of leaving the pods stuck ContainerCreating on a
+// missing volume. The inline Config needs no check - the operator creates
+// its config map itself.
+func (c *clusterOperator) checkCustomConfig(cluster *crv1.MySQLCluster) error {
+	if cluster.Spec.ConfigMapName == "" {
+		return nil
+	}
+
+	_, err := c.kubeClientset.CoreV1().ConfigMaps(cluster.Namespace).
+		Get(cluster.Spec.ConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf(
+			"cluster %q references the unknown config map %q",
+			cluster.Name, cluster.Spec.ConfigMapName)
+	}
+	return err
+}
+
+// createConfigConfigMap materializes the cluster's inline server
+// configuration as a config map owned by the cluster.
+func (c *clusterOperator) createConfigConfigMap(cluster *crv1.MySQLCluster) error {
+	configMapInterface := c.kubeClientset.CoreV1().ConfigMaps(cluster.Namespace)
+	configMap := new(corev1.ConfigMap)
+	err := util.ObjectFromTemplate(cluster, configMap, configMapConfigTemplate, FuncMap)
+	if err != nil {
+		return err
+	}
+
+	_, err = configMapInterface.Create(configMap)
+	if apierrors.IsAlreadyExists(err) {
+		// The inline config may have changed - converge the existing map.
+		logging.LogCluster(cluster).Debug("Updating the custom config map.")
+		_, err = configMapInterface.Update(configMap)
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// setDegraded marks the cluster Degraded, respecting the degraded grace
+// period: the state only flips once the cluster has been unhealthy
+// continuously for the whole period. Within the period only the time the
+// unhealthiness started is recorded, so a transient pod restart does not
+// page anyone.
+func (c *clusterOperator) setDegraded(cluster *crv1.MySQLCluster, message string) error {
+	grace := time.Duration(cluster.Spec.DegradedGracePeriodSeconds) * time.Second
+	if grace <= 0 {
+		return c.setClusterState(cluster, "Degraded", message)
+	}
+
+	now := metav1.Now()
+	if cluster.Status.UnhealthySince == nil {
+		cluster.Status.UnhealthySince = &now
+	}
+
+	if now.Sub(cluster.Status.UnhealthySince.Time) >= grace {
+		return c.setClusterState(cluster, "Degraded", message)
+	}
+
+	logging.LogCluster(cluster).WithField(
+		"reason", message).Info("Unhealthy within the degraded grace period")
+	_, err := c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// clearUnhealthy resets the unhealthy tracking after a clean reconcile, so
+// the next blip starts a fresh grace window.
+func clearUnhealthy(cluster *crv1.MySQLCluster) {
+	cluster.Status.UnhealthySince = nil
+}
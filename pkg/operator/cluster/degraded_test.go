@@ -0,0 +1,70 @@
+package cluster
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Degraded", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	Describe("setDegraded", func() {
+		It("flips immediately without a grace period", func() {
+			Expect(operator.setDegraded(cluster, "not enough ready members")).To(Succeed())
+			Expect(cluster.Status.State).To(Equal("Degraded"))
+		})
+
+		It("only tracks a blip within the grace period", func() {
+			cluster.Spec.DegradedGracePeriodSeconds = 300
+
+			Expect(operator.setDegraded(cluster, "not enough ready members")).To(Succeed())
+			Expect(cluster.Status.State).NotTo(Equal("Degraded"))
+			Expect(cluster.Status.UnhealthySince).NotTo(BeNil())
+		})
+
+		It("flips once the cluster stays unhealthy past the grace period", func() {
+			cluster.Spec.DegradedGracePeriodSeconds = 300
+			unhealthySince := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+			cluster.Status.UnhealthySince = &unhealthySince
+
+			Expect(operator.setDegraded(cluster, "not enough ready members")).To(Succeed())
+			Expect(cluster.Status.State).To(Equal("Degraded"))
+		})
+
+		It("restarts the grace window after a clean reconcile", func() {
+			cluster.Spec.DegradedGracePeriodSeconds = 300
+			unhealthySince := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+			cluster.Status.UnhealthySince = &unhealthySince
+
+			// The readiness flapped back - the next blip starts fresh.
+			clearUnhealthy(cluster)
+			Expect(operator.setDegraded(cluster, "not enough ready members")).To(Succeed())
+			Expect(cluster.Status.State).NotTo(Equal("Degraded"))
+		})
+	})
+})
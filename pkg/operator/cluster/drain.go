@@ -0,0 +1,86 @@
+package cluster
+
+import (
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// drainScaleDown drains the replicas a scale-down is about to remove. The
+// doomed pods are relabeled out of the read service selector, then the
+// configured grace period lets the open connections finish before the
+// StatefulSet deletes the pods. Without a connection drain period this is a
+// no-op.
+func (c *clusterOperator) drainScaleDown(cluster *crv1.MySQLCluster) error {
+	if cluster.Spec.ConnectionDrainSeconds <= 0 {
+		return nil
+	}
+
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	statefulSet, err := statefulSetInterface.Get(StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	newReplicas := cluster.Spec.Replicas
+	if newReplicas >= replicasOrDefault(statefulSet) {
+		return nil
+	}
+
+	drained, err := c.markDraining(cluster, newReplicas)
+	if err != nil {
+		return err
+	}
+	if drained == 0 {
+		return nil
+	}
+
+	gracePeriod := time.Duration(cluster.Spec.ConnectionDrainSeconds) * time.Second
+	logging.LogCluster(cluster).WithField(
+		"gracePeriod", gracePeriod).Info("Draining connections before scale-down.")
+	time.Sleep(gracePeriod)
+
+	return nil
+}
+
+// markDraining labels the pods at ordinals beyond the given replica count as
+// draining, removing them from the read service selector. It returns the
+// number of pods it relabeled.
+func (c *clusterOperator) markDraining(cluster *crv1.MySQLCluster, replicas int32) (int, error) {
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	pods, err := podInterface.List(listOptionsForCluster(cluster))
+	if err != nil {
+		return 0, err
+	}
+
+	drained := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil || int32(ordinal) < replicas {
+			continue
+		}
+		if pod.Labels[RoleLabel] == RoleDraining {
+			continue
+		}
+
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[RoleLabel] = RoleDraining
+		_, err = podInterface.Update(pod)
+		if err != nil {
+			return drained, err
+		}
+		drained++
+	}
+
+	return drained, nil
+}
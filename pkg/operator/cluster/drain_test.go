@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Drain", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.WithDefaults()
+
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(),
+			kubeClientset: fake.NewSimpleClientset(
+				readyPodWithRole(cluster.Name, 0, RolePrimary),
+				readyPodWithRole(cluster.Name, 1, RoleReplica),
+				readyPodWithRole(cluster.Name, 2, RoleReplica),
+			),
+		}
+	})
+
+	Describe("markDraining", func() {
+		It("relabels only the pods beyond the new replica count", func() {
+			drained, err := operator.markDraining(cluster, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drained).To(Equal(1))
+
+			podInterface := operator.kubeClientset.CoreV1().Pods(cluster.Namespace)
+			doomed, err := podInterface.Get(PodName(cluster.Name, 2), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doomed.Labels).To(HaveKeyWithValue(RoleLabel, RoleDraining))
+
+			survivor, err := podInterface.Get(PodName(cluster.Name, 1), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(survivor.Labels).NotTo(HaveKeyWithValue(RoleLabel, RoleDraining))
+		})
+
+		It("relabels an already draining pod only once", func() {
+			_, err := operator.markDraining(cluster, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			drained, err := operator.markDraining(cluster, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drained).To(Equal(0))
+		})
+	})
+
+	Describe("drainScaleDown", func() {
+		It("does nothing without a connection drain period", func() {
+			cluster.Spec.Replicas = 2
+			Expect(operator.drainScaleDown(cluster)).To(Succeed())
+
+			podInterface := operator.kubeClientset.CoreV1().Pods(cluster.Namespace)
+			pod, err := podInterface.Get(PodName(cluster.Name, 2), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Labels).NotTo(HaveKeyWithValue(RoleLabel, RoleDraining))
+		})
+	})
+})
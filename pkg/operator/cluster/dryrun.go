@@ -0,0 +1,133 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// DryRunAnnotation makes the operator report what the next reconcile would
+// change instead of applying it. The summary is written to the cluster status.
+const DryRunAnnotation = "cr.mysqloperator.grtl.github.com/dry-run"
+
+// isDryRun returns true when the cluster requested a dry run via the
+// DryRunAnnotation.
+func isDryRun(cluster *crv1.MySQLCluster) bool {
+	return cluster.Annotations[DryRunAnnotation] == "true"
+}
+
+// reportDryRunDiff renders the desired service and stateful set, compares
+// them against the live objects and records a human-readable summary of the
+// differences in the cluster status, without applying anything.
+func (c *clusterOperator) reportDryRunDiff(cluster *crv1.MySQLCluster) error {
+	diff, err := c.serviceDiff(cluster)
+	if err != nil {
+		return err
+	}
+
+	statefulSetDiff, err := c.statefulSetDiff(cluster)
+	if err != nil {
+		return err
+	}
+	diff = append(diff, statefulSetDiff...)
+
+	cluster.Status.DryRunDiff = "no changes"
+	if len(diff) > 0 {
+		cluster.Status.DryRunDiff = strings.Join(diff, "; ")
+	}
+
+	return c.setClusterState(cluster, "Dry run", "Reconcile skipped, see dryRunDiff")
+}
+
+func (c *clusterOperator) serviceDiff(cluster *crv1.MySQLCluster) ([]string, error) {
+	desired, err := serviceForCluster(cluster, serviceTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceInterface := c.kubeClientset.CoreV1().Services(cluster.Namespace)
+	live, err := serviceInterface.Get(desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []string{fmt.Sprintf("service %q would be created", desired.Name)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return diffServices(desired, live), nil
+}
+
+func (c *clusterOperator) statefulSetDiff(cluster *crv1.MySQLCluster) ([]string, error) {
+	desired, err := statefulSetForCluster(cluster, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	live, err := statefulSetInterface.Get(desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []string{fmt.Sprintf("stateful set %q would be created", desired.Name)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return diffStatefulSets(desired, live), nil
+}
+
+func diffServices(desired, live *corev1.Service) []string {
+	var diff []string
+
+	if len(desired.Spec.Ports) > 0 && len(live.Spec.Ports) > 0 {
+		desiredPort := desired.Spec.Ports[0].Port
+		livePort := live.Spec.Ports[0].Port
+		if desiredPort != livePort {
+			diff = append(diff, fmt.Sprintf(
+				"service %q port: %d -> %d", desired.Name, livePort, desiredPort))
+		}
+	}
+
+	return diff
+}
+
+func diffStatefulSets(desired, live *appsv1.StatefulSet) []string {
+	var diff []string
+
+	desiredReplicas := replicasOrDefault(desired)
+	liveReplicas := replicasOrDefault(live)
+	if desiredReplicas != liveReplicas {
+		diff = append(diff, fmt.Sprintf(
+			"stateful set %q replicas: %d -> %d", desired.Name, liveReplicas, desiredReplicas))
+	}
+
+	desiredImage := containerImage(desired, "mysql")
+	liveImage := containerImage(live, "mysql")
+	if desiredImage != liveImage {
+		diff = append(diff, fmt.Sprintf(
+			"stateful set %q image: %s -> %s", desired.Name, liveImage, desiredImage))
+	}
+
+	return diff
+}
+
+func replicasOrDefault(statefulSet *appsv1.StatefulSet) int32 {
+	if statefulSet.Spec.Replicas == nil {
+		return 1
+	}
+	return *statefulSet.Spec.Replicas
+}
+
+func containerImage(statefulSet *appsv1.StatefulSet, name string) string {
+	for _, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name == name {
+			return container.Image
+		}
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func statefulSetWith(name string, replicas int32, image string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "mysql", Image: image},
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Dry Run", func() {
+	Describe("diffStatefulSets", func() {
+		It("reports changed replicas and image", func() {
+			desired := statefulSetWith("my-cluster", 3, "mysql:5.7")
+			live := statefulSetWith("my-cluster", 2, "mysql:5.6")
+			Expect(diffStatefulSets(desired, live)).To(Equal([]string{
+				`stateful set "my-cluster" replicas: 2 -> 3`,
+				`stateful set "my-cluster" image: mysql:5.6 -> mysql:5.7`,
+			}))
+		})
+
+		It("reports nothing when the stateful sets match", func() {
+			desired := statefulSetWith("my-cluster", 2, "mysql:5.7")
+			live := statefulSetWith("my-cluster", 2, "mysql:5.7")
+			Expect(diffStatefulSets(desired, live)).To(BeEmpty())
+		})
+	})
+
+	Describe("diffServices", func() {
+		It("reports a changed port", func() {
+			desired := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 3307}},
+				},
+			}
+			live := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 3306}},
+				},
+			}
+			Expect(diffServices(desired, live)).To(Equal([]string{
+				`service "my-cluster" port: 3306 -> 3307`,
+			}))
+		})
+	})
+})
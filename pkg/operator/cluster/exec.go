@@ -0,0 +1,104 @@
+package cluster
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"golang.org/x/net/websocket"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// Channels of the API server's websocket exec protocol. Every frame starts
+// with the channel digit; the rest is the base64-encoded payload.
+const (
+	execStdoutChannel = '1'
+	execStderrChannel = '2'
+	execErrorChannel  = '3'
+)
+
+// execInPod runs the command through bash in the mysql container of the pod
+// with the given ordinal and returns its standard output. The SPDY executor
+// of the vendored client libraries needs a streaming library that is not
+// vendored, so the exec rides the API server's websocket protocol instead.
+func (c *clusterOperator) execInPod(cluster *crv1.MySQLCluster, ordinal int, command string) (string, error) {
+	request := c.kubeClientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(cluster.Namespace).
+		Name(PodName(cluster.ResourceName(), ordinal)).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: "mysql",
+			Command:   []string{"bash", "-c", command},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	location := request.URL()
+	switch location.Scheme {
+	case "https":
+		location.Scheme = "wss"
+	case "http":
+		location.Scheme = "ws"
+	}
+
+	wsConfig, err := websocket.NewConfig(location.String(), location.String())
+	if err != nil {
+		return "", err
+	}
+	// The base64 flavor keeps the channel byte unambiguous in text frames.
+	wsConfig.Protocol = []string{"base64.channel.k8s.io"}
+
+	wsConfig.TlsConfig, err = rest.TLSConfigFor(c.restConfig)
+	if err != nil {
+		return "", err
+	}
+	if c.restConfig.BearerToken != "" {
+		wsConfig.Header.Set("Authorization", "Bearer "+c.restConfig.BearerToken)
+	}
+
+	connection, err := websocket.DialConfig(wsConfig)
+	if err != nil {
+		return "", err
+	}
+	defer connection.Close()
+
+	var stdout, execError bytes.Buffer
+	for {
+		var message []byte
+		err = websocket.Message.Receive(connection, &message)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if len(message) < 2 {
+			continue
+		}
+
+		payload, err := base64.StdEncoding.DecodeString(string(message[1:]))
+		if err != nil {
+			return "", err
+		}
+
+		switch message[0] {
+		case execStdoutChannel:
+			stdout.Write(payload)
+		case execErrorChannel:
+			execError.Write(payload)
+		}
+	}
+
+	if execError.Len() > 0 {
+		return stdout.String(), fmt.Errorf("exec failed: %s", execError.String())
+	}
+
+	return stdout.String(), nil
+}
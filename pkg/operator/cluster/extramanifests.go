@@ -0,0 +1,124 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// applyExtraManifests applies the unmodeled objects listed in the cluster
+// spec. Each object is stamped with the cluster owner reference, so the
+// garbage collector removes it together with the cluster - no separate
+// cleanup is needed on delete.
+func (c *clusterOperator) applyExtraManifests(cluster *crv1.MySQLCluster) error {
+	if len(cluster.Spec.ExtraManifests) == 0 {
+		return nil
+	}
+	if c.restConfig == nil {
+		logging.LogCluster(cluster).Warn(
+			"No REST config available, skipping the extra manifests.")
+		return nil
+	}
+
+	applyErrs := []error{}
+	for _, manifest := range cluster.Spec.ExtraManifests {
+		object := new(unstructured.Unstructured)
+		err := object.UnmarshalJSON(manifest.Raw)
+		if err != nil {
+			// Validate catches this on admission - kept as a backstop for
+			// stored objects.
+			applyErrs = append(applyErrs, err)
+			continue
+		}
+
+		err = c.applyExtraManifest(cluster, object)
+		if err != nil {
+			applyErrs = append(applyErrs, fmt.Errorf(
+				"unable to apply the extra %s %q: %v",
+				object.GetKind(), object.GetName(), err))
+		}
+	}
+
+	return errors.NewAggregate(applyErrs)
+}
+
+// applyExtraManifest creates the object, or overwrites an existing one with
+// the desired content - the object exists only because the spec lists it, so
+// the operator owns it in full.
+func (c *clusterOperator) applyExtraManifest(cluster *crv1.MySQLCluster, object *unstructured.Unstructured) error {
+	object.SetNamespace(cluster.Namespace)
+	object.SetOwnerReferences(append(object.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion: crv1.SchemeGroupVersion.String(),
+		Kind:       "MySQLCluster",
+		Name:       cluster.Name,
+		UID:        cluster.UID,
+	}))
+
+	resourceClient, err := c.extraManifestClient(object)
+	if err != nil {
+		return err
+	}
+
+	_, err = resourceClient.Create(object)
+	if apierrors.IsAlreadyExists(err) {
+		live, getErr := resourceClient.Get(object.GetName(), metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		object.SetResourceVersion(live.GetResourceVersion())
+		_, err = resourceClient.Update(object)
+	}
+	return err
+}
+
+// extraManifestClient builds a dynamic client for the object's resource,
+// resolved via discovery from its apiVersion and kind.
+func (c *clusterOperator) extraManifestClient(object *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	groupVersion, err := schema.ParseGroupVersion(object.GetAPIVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := c.kubeClientset.Discovery().
+		ServerResourcesForGroupVersion(groupVersion.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var resource *metav1.APIResource
+	for i := range resources.APIResources {
+		candidate := &resources.APIResources[i]
+		// Skip subresources like deployments/scale.
+		if candidate.Kind == object.GetKind() && !strings.Contains(candidate.Name, "/") {
+			resource = candidate
+			break
+		}
+	}
+	if resource == nil {
+		return nil, fmt.Errorf("the server does not serve %s in %s",
+			object.GetKind(), groupVersion)
+	}
+
+	confCopy := *c.restConfig
+	confCopy.GroupVersion = &groupVersion
+	if groupVersion.Group == "" {
+		confCopy.APIPath = "/api"
+	} else {
+		confCopy.APIPath = "/apis"
+	}
+
+	client, err := dynamic.NewClient(&confCopy)
+	if err != nil {
+		return nil, err
+	}
+	return client.Resource(resource, object.GetNamespace()), nil
+}
@@ -0,0 +1,44 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("ExtraManifests", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	It("is a no-op without extra manifests", func() {
+		Expect(operator.applyExtraManifests(cluster)).To(Succeed())
+	})
+
+	It("skips gracefully without a REST config", func() {
+		cluster.Spec.ExtraManifests = []runtime.RawExtension{
+			{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"extra"}}`)},
+		}
+		Expect(operator.applyExtraManifests(cluster)).To(Succeed())
+	})
+})
@@ -32,3 +32,45 @@ func (c *FakeClusterOperator) UpdateCluster(newCluster *crv1.MySQLCluster) error
 	// Just pretend we're updating a cluster. Do nothing.
 	return c.err
 }
+
+// DeleteCluster simulates deleting a cluster. Returns fail set via SetError.
+func (c *FakeClusterOperator) DeleteCluster(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're deleting a cluster. Do nothing.
+	return c.err
+}
+
+// FinalizeCluster simulates finalizing a cluster. Returns fail set via SetError.
+func (c *FakeClusterOperator) FinalizeCluster(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're finalizing a cluster. Do nothing.
+	return c.err
+}
+
+// CheckSplitBrain simulates the split brain check. Returns fail set via SetError.
+func (c *FakeClusterOperator) CheckSplitBrain(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're checking the cluster. Do nothing.
+	return c.err
+}
+
+// CheckNodeMaintenance simulates the node maintenance check. Returns fail set via SetError.
+func (c *FakeClusterOperator) CheckNodeMaintenance(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're checking the cluster. Do nothing.
+	return c.err
+}
+
+// CheckStoragePressure simulates the storage pressure check. Returns fail set via SetError.
+func (c *FakeClusterOperator) CheckStoragePressure(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're checking the cluster. Do nothing.
+	return c.err
+}
+
+// CheckZoneAffinity simulates the zone affinity check. Returns fail set via SetError.
+func (c *FakeClusterOperator) CheckZoneAffinity(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're checking the cluster. Do nothing.
+	return c.err
+}
+
+// RecommendResources simulates the resource recommendation pass. Returns fail set via SetError.
+func (c *FakeClusterOperator) RecommendResources(cluster *crv1.MySQLCluster) error {
+	// Just pretend we're recommending resources. Do nothing.
+	return c.err
+}
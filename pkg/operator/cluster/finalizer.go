@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// ClusterFinalizer is the finalizer the operator adds to clusters so their
+// data PVCs can be cleaned up before the cluster object is removed. Only this
+// finalizer is ever added or removed - finalizers owned by other controllers
+// are left untouched.
+const ClusterFinalizer = "cr.mysqloperator.grtl.github.com/pvc-cleanup"
+
+// hasFinalizer returns true when the given finalizer is present in the slice.
+func hasFinalizer(finalizers []string, finalizer string) bool {
+	for _, f := range finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// addFinalizer returns the finalizers with the given finalizer appended.
+// The slice is returned unchanged when the finalizer is already present.
+func addFinalizer(finalizers []string, finalizer string) []string {
+	if hasFinalizer(finalizers, finalizer) {
+		return finalizers
+	}
+	return append(finalizers, finalizer)
+}
+
+// removeFinalizer returns the finalizers without the given finalizer,
+// preserving any foreign finalizers and their order.
+func removeFinalizer(finalizers []string, finalizer string) []string {
+	result := make([]string, 0, len(finalizers))
+	for _, f := range finalizers {
+		if f != finalizer {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// ensureFinalizer makes sure the operator's finalizer is set on the cluster.
+func (c *clusterOperator) ensureFinalizer(cluster *crv1.MySQLCluster) error {
+	if hasFinalizer(cluster.Finalizers, ClusterFinalizer) {
+		return nil
+	}
+
+	cluster.Finalizers = addFinalizer(cluster.Finalizers, ClusterFinalizer)
+	_, err := c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// FinalizeCluster removes the data PVCs of a cluster being deleted and then
+// strips the operator's finalizer so the deletion can proceed.
+func (c *clusterOperator) FinalizeCluster(cluster *crv1.MySQLCluster) error {
+	if !hasFinalizer(cluster.Finalizers, ClusterFinalizer) {
+		return nil
+	}
+
+	logging.LogCluster(cluster).Debug("Removing data PVCs.")
+	err := c.removeDataPVCs(cluster)
+	if err != nil {
+		return err
+	}
+
+	c.recordAudit(cluster, "Delete", "Cluster deleted, data PVCs removed")
+
+	cluster.Finalizers = removeFinalizer(cluster.Finalizers, ClusterFinalizer)
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// removeDataPVCs deletes the cluster's data volume claims. The claims are
+// found via the cluster's selector rather than by ordinal, so claims kept
+// from a previous, larger replica count are cleaned up too. Already removed
+// claims are tolerated, so a retried finalize does not error.
+func (c *clusterOperator) removeDataPVCs(cluster *crv1.MySQLCluster) error {
+	pvcInterface := c.kubeClientset.CoreV1().PersistentVolumeClaims(cluster.Namespace)
+	pvcs, err := pvcInterface.List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+
+	for i := range pvcs.Items {
+		err := pvcInterface.Delete(pvcs.Items[i].Name, new(metav1.DeleteOptions))
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
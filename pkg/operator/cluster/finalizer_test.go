@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Finalizer helpers", func() {
+	const foreignFinalizer = "example.com/other-controller"
+
+	Describe("addFinalizer", func() {
+		It("should append the finalizer preserving foreign ones", func() {
+			finalizers := addFinalizer([]string{foreignFinalizer}, ClusterFinalizer)
+			Expect(finalizers).To(Equal([]string{foreignFinalizer, ClusterFinalizer}))
+		})
+
+		It("should not duplicate an already present finalizer", func() {
+			finalizers := addFinalizer([]string{ClusterFinalizer}, ClusterFinalizer)
+			Expect(finalizers).To(Equal([]string{ClusterFinalizer}))
+		})
+	})
+
+	Describe("removeFinalizer", func() {
+		It("should remove only the given finalizer", func() {
+			finalizers := removeFinalizer(
+				[]string{foreignFinalizer, ClusterFinalizer}, ClusterFinalizer)
+			Expect(finalizers).To(Equal([]string{foreignFinalizer}))
+		})
+
+		It("should leave the slice untouched when the finalizer is absent", func() {
+			finalizers := removeFinalizer([]string{foreignFinalizer}, ClusterFinalizer)
+			Expect(finalizers).To(Equal([]string{foreignFinalizer}))
+		})
+	})
+})
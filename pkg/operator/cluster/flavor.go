@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// LivenessProbeCommand returns the health-check command matching the given
+// flavor. MariaDB images authenticate root via the unix socket, so their
+// probe has to go over TCP explicitly; the MySQL and Percona clients
+// default to a socket which works in their images.
+func LivenessProbeCommand(flavor string) string {
+	switch flavor {
+	case crv1.FlavorMariaDB:
+		return `mysqladmin ping -h 127.0.0.1 -p"${MYSQL_ROOT_PASSWORD}"`
+	default:
+		return `mysqladmin ping -p"${MYSQL_ROOT_PASSWORD}"`
+	}
+}
+
+// ReadinessProbeCommand returns the readiness command matching the given
+// flavor. All flavors check that queries work over TCP (skip-networking is
+// off).
+func ReadinessProbeCommand(flavor string) string {
+	return `mysql -h 127.0.0.1 -p"${MYSQL_ROOT_PASSWORD}" -e 'SELECT 1'`
+}
+
+// FlavorConfig returns extra my.cnf settings the given flavor needs for the
+// operator's replication setup, appended to the generated config.
+func FlavorConfig(flavor string) []string {
+	switch flavor {
+	case crv1.FlavorMariaDB:
+		// MariaDB defaults to statement-based binlogs; the operator's
+		// replication setup assumes row-based ones like MySQL 5.7+.
+		return []string{"binlog_format=ROW"}
+	default:
+		return nil
+	}
+}
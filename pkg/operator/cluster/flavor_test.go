@@ -0,0 +1,33 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Flavor", func() {
+	Describe("LivenessProbeCommand", func() {
+		It("probes MariaDB over TCP", func() {
+			Expect(LivenessProbeCommand(crv1.FlavorMariaDB)).To(
+				ContainSubstring("-h 127.0.0.1"))
+		})
+
+		It("probes MySQL via the socket", func() {
+			Expect(LivenessProbeCommand(crv1.FlavorMySQL)).NotTo(
+				ContainSubstring("-h 127.0.0.1"))
+		})
+	})
+
+	Describe("FlavorConfig", func() {
+		It("forces row-based binlogs for MariaDB", func() {
+			Expect(FlavorConfig(crv1.FlavorMariaDB)).To(
+				ContainElement("binlog_format=ROW"))
+		})
+
+		It("adds nothing for MySQL", func() {
+			Expect(FlavorConfig(crv1.FlavorMySQL)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package cluster
+
+import (
+	"strings"
+
+	"github.com/grtl/mysql-operator/pkg/config"
+)
+
+// Image returns the given image with the configured registry prefix
+// prepended, redirecting operator-managed images to a mirror. Fully
+// qualified images (those already naming a registry host) are returned
+// unchanged, so explicit overrides keep working.
+func Image(image string) string {
+	return prefixImage(config.Get().RegistryPrefix, image)
+}
+
+func prefixImage(prefix, image string) string {
+	if prefix == "" || fullyQualified(image) {
+		return image
+	}
+	return prefix + "/" + image
+}
+
+// fullyQualified returns true when the image already names a registry host,
+// i.e. its first path segment contains a dot or a port, or is "localhost".
+func fullyQualified(image string) bool {
+	index := strings.Index(image, "/")
+	if index == -1 {
+		return false
+	}
+	host := image[:index]
+	return strings.ContainsAny(host, ".:") || host == "localhost"
+}
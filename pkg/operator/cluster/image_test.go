@@ -0,0 +1,26 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Image", func() {
+	It("leaves images unchanged without a registry prefix", func() {
+		Expect(prefixImage("", "mysql:8.0")).To(Equal("mysql:8.0"))
+	})
+
+	It("prepends the registry prefix", func() {
+		Expect(prefixImage("registry.example.com/mirror", "mysql:8.0")).To(
+			Equal("registry.example.com/mirror/mysql:8.0"))
+		Expect(prefixImage("registry.example.com", "grtl/xtrabackup:latest")).To(
+			Equal("registry.example.com/grtl/xtrabackup:latest"))
+	})
+
+	It("leaves fully qualified images unchanged", func() {
+		Expect(prefixImage("registry.example.com", "other.io/mysql:8.0")).To(
+			Equal("other.io/mysql:8.0"))
+		Expect(prefixImage("registry.example.com", "localhost:5000/mysql:8.0")).To(
+			Equal("localhost:5000/mysql:8.0"))
+	})
+})
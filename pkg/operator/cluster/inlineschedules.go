@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// InlineScheduleName returns the name of the MySQLBackupSchedule object
+// materialized for the cluster's inline schedule of the given name.
+func InlineScheduleName(clusterName, scheduleName string) string {
+	return fmt.Sprintf("%s-%s", clusterName, scheduleName)
+}
+
+// reconcileInlineSchedules materializes the cluster's inline backup schedules
+// as MySQLBackupSchedule objects owned by the cluster: missing ones are
+// created, ones whose inline spec changed are updated and ones no longer
+// declared are deleted. Cluster deletion needs no handling here - the owner
+// reference garbage collects the schedules with the cluster.
+func (c *clusterOperator) reconcileInlineSchedules(cluster *crv1.MySQLCluster) error {
+	scheduleInterface := c.clientset.CrV1().MySQLBackupSchedules(cluster.Namespace)
+
+	declared := map[string]bool{}
+	for i := range cluster.Spec.BackupSchedules {
+		desired := inlineSchedule(cluster, &cluster.Spec.BackupSchedules[i])
+		declared[desired.Name] = true
+
+		live, err := scheduleInterface.Get(desired.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = scheduleInterface.Create(desired)
+			if err != nil && !apierrors.IsAlreadyExists(err) {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(live.Spec, desired.Spec) {
+			live.Spec = desired.Spec
+			_, err = scheduleInterface.Update(live)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Prune the schedules the cluster owns but no longer declares. Schedules
+	// managed outside the cluster spec carry no owner reference and are left
+	// alone.
+	schedules, err := scheduleInterface.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range schedules.Items {
+		schedule := &schedules.Items[i]
+		if declared[schedule.Name] || !ownedByCluster(&schedule.ObjectMeta, cluster) {
+			continue
+		}
+		err = scheduleInterface.Delete(schedule.Name, &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inlineSchedule builds the MySQLBackupSchedule object an inline schedule
+// spec materializes as.
+func inlineSchedule(cluster *crv1.MySQLCluster, inline *crv1.InlineScheduleSpec) *crv1.MySQLBackupSchedule {
+	schedule := &crv1.MySQLBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InlineScheduleName(cluster.Name, inline.Name),
+			Namespace: cluster.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: crv1.SchemeGroupVersion.String(),
+				Kind:       "MySQLCluster",
+				Name:       cluster.Name,
+				UID:        cluster.UID,
+			}},
+		},
+		Spec: *inline.MySQLBackupScheduleSpec.DeepCopy(),
+	}
+	schedule.Spec.Cluster = cluster.Name
+	return schedule
+}
+
+// ownedByCluster reports whether the object carries the cluster as an owner.
+func ownedByCluster(meta *metav1.ObjectMeta, cluster *crv1.MySQLCluster) bool {
+	for _, owner := range meta.OwnerReferences {
+		if owner.UID == cluster.UID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Inline backup schedules", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	inline := func(name, time string) crv1.InlineScheduleSpec {
+		return crv1.InlineScheduleSpec{
+			Name: name,
+			MySQLBackupScheduleSpec: crv1.MySQLBackupScheduleSpec{
+				Time: time,
+			},
+		}
+	}
+
+	schedules := func() *crv1.MySQLBackupScheduleList {
+		list, err := operator.clientset.CrV1().
+			MySQLBackupSchedules(metav1.NamespaceDefault).List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return list
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.UID = "cluster-uid"
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	It("materializes the declared schedules owned by the cluster", func() {
+		cluster.Spec.BackupSchedules = []crv1.InlineScheduleSpec{
+			inline("daily", "0 4 * * *"),
+		}
+
+		Expect(operator.reconcileInlineSchedules(cluster)).To(Succeed())
+
+		schedule, err := operator.clientset.CrV1().
+			MySQLBackupSchedules(metav1.NamespaceDefault).
+			Get(InlineScheduleName(cluster.Name, "daily"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule.Spec.Cluster).To(Equal(cluster.Name))
+		Expect(schedule.Spec.Time).To(Equal("0 4 * * *"))
+		Expect(schedule.OwnerReferences).To(HaveLen(1))
+		Expect(schedule.OwnerReferences[0].UID).To(Equal(cluster.UID))
+	})
+
+	It("updates a schedule whose inline spec changed", func() {
+		cluster.Spec.BackupSchedules = []crv1.InlineScheduleSpec{
+			inline("daily", "0 4 * * *"),
+		}
+		Expect(operator.reconcileInlineSchedules(cluster)).To(Succeed())
+
+		cluster.Spec.BackupSchedules[0].Time = "0 6 * * *"
+		Expect(operator.reconcileInlineSchedules(cluster)).To(Succeed())
+
+		schedule, err := operator.clientset.CrV1().
+			MySQLBackupSchedules(metav1.NamespaceDefault).
+			Get(InlineScheduleName(cluster.Name, "daily"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule.Spec.Time).To(Equal("0 6 * * *"))
+	})
+
+	It("prunes owned schedules no longer declared", func() {
+		cluster.Spec.BackupSchedules = []crv1.InlineScheduleSpec{
+			inline("daily", "0 4 * * *"),
+			inline("weekly", "0 4 * * 0"),
+		}
+		Expect(operator.reconcileInlineSchedules(cluster)).To(Succeed())
+
+		cluster.Spec.BackupSchedules = cluster.Spec.BackupSchedules[:1]
+		Expect(operator.reconcileInlineSchedules(cluster)).To(Succeed())
+
+		list := schedules()
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Name).To(Equal(InlineScheduleName(cluster.Name, "daily")))
+	})
+
+	It("leaves schedules managed outside the cluster spec alone", func() {
+		external := &crv1.MySQLBackupSchedule{}
+		external.Name = "hand-made"
+		external.Namespace = metav1.NamespaceDefault
+		external.Spec.Cluster = cluster.Name
+		_, err := operator.clientset.CrV1().
+			MySQLBackupSchedules(metav1.NamespaceDefault).Create(external)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(operator.reconcileInlineSchedules(cluster)).To(Succeed())
+
+		Expect(schedules().Items).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// lameDuckPollInterval is how often an updating pod is checked for having
+// come back on the new revision.
+const lameDuckPollInterval = 10 * time.Second
+
+// lameDuckPodTimeout bounds how long the rollout waits for a single pod to
+// come back before giving up on the update.
+const lameDuckPodTimeout = 10 * time.Minute
+
+// rollWithLameDuck drives the rolling update pod by pod via the update
+// strategy partition. Before the partition is lowered onto a pod, the pod is
+// relabeled out of the read service and the lame-duck period lets its open
+// connections finish; only then is the StatefulSet allowed to replace it.
+// The partition steps from the highest ordinal down, so the member at
+// ordinal 0 - the default primary - goes last.
+func (c *clusterOperator) rollWithLameDuck(cluster *crv1.MySQLCluster, desired *appsv1.StatefulSet) error {
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	gracePeriod := time.Duration(cluster.Spec.LameDuckSeconds) * time.Second
+
+	for ordinal := int(cluster.Spec.Replicas) - 1; ordinal >= 0; ordinal-- {
+		err := c.markLameDuck(cluster, ordinal)
+		if err != nil {
+			// A missing pod has no connections to drain.
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to lame-duck the pod, updating it anyway")
+		} else {
+			logging.LogCluster(cluster).WithField(
+				"gracePeriod", gracePeriod).Info("Draining connections before the update.")
+			time.Sleep(gracePeriod)
+		}
+
+		live, err := statefulSetInterface.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		merged := applyStatefulSet(live, desired)
+		partition := int32(ordinal)
+		merged.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+				Partition: &partition,
+			},
+		}
+		_, err = statefulSetInterface.Update(merged)
+		if err != nil {
+			return err
+		}
+
+		err = c.waitForPodUpdated(cluster, ordinal)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markLameDuck relabels the pod at the given ordinal as draining, removing
+// it from the read service selector.
+func (c *clusterOperator) markLameDuck(cluster *crv1.MySQLCluster, ordinal int) error {
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	pod, err := podInterface.Get(PodName(cluster.ResourceName(), ordinal), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if pod.Labels[RoleLabel] == RoleDraining {
+		return nil
+	}
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[RoleLabel] = RoleDraining
+	_, err = podInterface.Update(pod)
+	return err
+}
+
+// waitForPodUpdated blocks until the pod at the given ordinal is back on the
+// StatefulSet's update revision and ready, so the rollout only moves on once
+// the replaced member serves again.
+func (c *clusterOperator) waitForPodUpdated(cluster *crv1.MySQLCluster, ordinal int) error {
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+
+	return wait.PollImmediate(lameDuckPollInterval, lameDuckPodTimeout, func() (bool, error) {
+		statefulSet, err := statefulSetInterface.Get(
+			StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		pod, err := podInterface.Get(PodName(cluster.ResourceName(), ordinal), metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// The old pod is gone, the new one has yet to appear.
+				return false, nil
+			}
+			return false, err
+		}
+
+		if pod.Labels[appsv1.StatefulSetRevisionLabel] != statefulSet.Status.UpdateRevision {
+			return false, nil
+		}
+		return IsPodReady(pod), nil
+	})
+}
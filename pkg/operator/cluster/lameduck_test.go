@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Lame duck", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+
+		pod := readyPodWithRole(cluster.Name, 1, RoleReplica)
+		pod.Namespace = metav1.NamespaceDefault
+		pod.Labels[RoleLabel] = RoleReplica
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(pod),
+		}
+	})
+
+	It("relabels the pod out of the read service", func() {
+		Expect(operator.markLameDuck(cluster, 1)).To(Succeed())
+
+		pod, err := operator.kubeClientset.CoreV1().Pods(metav1.NamespaceDefault).
+			Get(PodName(cluster.ResourceName(), 1), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pod.Labels[RoleLabel]).To(Equal(RoleDraining))
+	})
+
+	It("fails on a missing pod", func() {
+		Expect(operator.markLameDuck(cluster, 2)).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,148 @@
+package cluster
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// CheckNodeMaintenance detects a cordoned node hosting the primary and
+// switches the primary over to a replica on a schedulable node, so the
+// switchover happens gracefully before a node drain forces a messy
+// eviction. The switchover is recorded in the cluster's audit log.
+func (c *clusterOperator) CheckNodeMaintenance(cluster *crv1.MySQLCluster) error {
+	if cluster.Spec.Replicas < 2 {
+		// There is no replica to switch over to.
+		return nil
+	}
+
+	pods, err := c.kubeClientset.CoreV1().Pods(cluster.Namespace).
+		List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+
+	primary := primaryOrdinal(pods.Items)
+	primaryPod := podWithOrdinal(pods.Items, primary)
+	if primaryPod == nil || primaryPod.Spec.NodeName == "" {
+		return nil
+	}
+
+	cordoned, err := c.nodeCordoned(primaryPod.Spec.NodeName)
+	if err != nil {
+		return err
+	}
+	if !cordoned {
+		return nil
+	}
+
+	target, err := c.switchoverTarget(cluster, pods.Items, primary)
+	if err != nil {
+		return err
+	}
+	if target < 0 {
+		logging.LogCluster(cluster).Warn(
+			"The primary's node is cordoned but no ready replica runs on a schedulable node")
+		return nil
+	}
+
+	return c.switchOver(cluster, pods.Items, primary, target, fmt.Sprintf(
+		"node %q is cordoned", primaryPod.Spec.NodeName))
+}
+
+// nodeCordoned reports whether scheduling is disabled on the named node.
+func (c *clusterOperator) nodeCordoned(nodeName string) (bool, error) {
+	node, err := c.kubeClientset.CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return node.Spec.Unschedulable, nil
+}
+
+// switchoverTarget returns the ordinal of the lowest ready replica running
+// on a schedulable node, or -1 when there is none.
+func (c *clusterOperator) switchoverTarget(cluster *crv1.MySQLCluster, pods []corev1.Pod, primary int) (int, error) {
+	target := -1
+	for i := range pods {
+		pod := &pods[i]
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil || ordinal == primary || int32(ordinal) >= cluster.Spec.Replicas {
+			continue
+		}
+		if !IsPodReady(pod) || pod.Spec.NodeName == "" {
+			continue
+		}
+
+		cordoned, err := c.nodeCordoned(pod.Spec.NodeName)
+		if err != nil {
+			return -1, err
+		}
+		if cordoned {
+			continue
+		}
+
+		if target == -1 || ordinal < target {
+			target = ordinal
+		}
+	}
+	return target, nil
+}
+
+// switchOver gracefully moves the primary role from the given ordinal to the
+// target: the old primary is fenced read-only first when the operator can
+// exec into it, then the role annotations and labels are moved over.
+func (c *clusterOperator) switchOver(cluster *crv1.MySQLCluster, pods []corev1.Pod, primary, target int, reason string) error {
+	logging.LogCluster(cluster).WithField(
+		"target", target).Info("Switching the primary over: " + reason)
+
+	if c.restConfig != nil {
+		// Fence the old primary first, so no writes are lost mid-switch.
+		// Best effort - the pod may already be gone.
+		err := c.execOnPod(cluster, primary, readOnlyCommand(true))
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to fence the old primary read-only")
+		}
+	}
+
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	for ordinal, role := range map[int]string{target: RolePrimary, primary: RoleReplica} {
+		pod := podWithOrdinal(pods, ordinal)
+		if pod == nil {
+			continue
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[RoleAnnotation] = role
+		_, err := podInterface.Update(pod)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.labelPodRoles(cluster, target)
+	if err != nil {
+		return err
+	}
+
+	c.recordAudit(cluster, "Switchover", fmt.Sprintf(
+		"Primary switched from ordinal %d to %d: %s", primary, target, reason))
+	return nil
+}
+
+// podWithOrdinal returns the pod with the given stateful set ordinal, or nil
+// when it is not in the list.
+func podWithOrdinal(pods []corev1.Pod, ordinal int) *corev1.Pod {
+	for i := range pods {
+		podOrdinal, err := PodOrdinal(pods[i].Name)
+		if err == nil && podOrdinal == ordinal {
+			return &pods[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Node Maintenance", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	node := func(name string, cordoned bool) *corev1.Node {
+		node := new(corev1.Node)
+		node.Name = name
+		node.Spec.Unschedulable = cordoned
+		return node
+	}
+
+	podOnNode := func(ordinal int, role, nodeName string) *corev1.Pod {
+		pod := readyPodWithRole(cluster.Name, ordinal, role)
+		pod.Namespace = metav1.NamespaceDefault
+		pod.Spec.NodeName = nodeName
+		return pod
+	}
+
+	roleAnnotation := func(ordinal int) string {
+		pod, err := operator.kubeClientset.CoreV1().Pods(metav1.NamespaceDefault).
+			Get(PodName(cluster.Name, ordinal), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return pod.Annotations[RoleAnnotation]
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.Spec.Replicas = 2
+		cluster.WithDefaults()
+	})
+
+	It("switches the primary over when its node is cordoned", func() {
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(
+				node("node-a", true),
+				node("node-b", false),
+				podOnNode(0, RolePrimary, "node-a"),
+				podOnNode(1, RoleReplica, "node-b"),
+			),
+		}
+
+		Expect(operator.CheckNodeMaintenance(cluster)).To(Succeed())
+
+		Expect(roleAnnotation(1)).To(Equal(RolePrimary))
+		Expect(roleAnnotation(0)).To(Equal(RoleReplica))
+
+		Expect(cluster.Status.AuditLog).NotTo(BeEmpty())
+		last := cluster.Status.AuditLog[len(cluster.Status.AuditLog)-1]
+		Expect(last.Action).To(Equal("Switchover"))
+		Expect(last.Summary).To(ContainSubstring("node-a"))
+	})
+
+	It("does nothing while the primary's node is schedulable", func() {
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(
+				node("node-a", false),
+				node("node-b", false),
+				podOnNode(0, RolePrimary, "node-a"),
+				podOnNode(1, RoleReplica, "node-b"),
+			),
+		}
+
+		Expect(operator.CheckNodeMaintenance(cluster)).To(Succeed())
+		Expect(roleAnnotation(0)).To(Equal(RolePrimary))
+		Expect(cluster.Status.AuditLog).To(BeEmpty())
+	})
+
+	It("stays put when no replica runs on a schedulable node", func() {
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(
+				node("node-a", true),
+				podOnNode(0, RolePrimary, "node-a"),
+				podOnNode(1, RoleReplica, "node-a"),
+			),
+		}
+
+		Expect(operator.CheckNodeMaintenance(cluster)).To(Succeed())
+		Expect(roleAnnotation(0)).To(Equal(RolePrimary))
+		Expect(cluster.Status.AuditLog).To(BeEmpty())
+	})
+})
@@ -0,0 +1,78 @@
+package cluster
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// reportMembers records a per-member breakdown of role, readiness and
+// replication lag in the cluster status, so dashboards can read the cluster
+// health from the custom resource alone.
+func (c *clusterOperator) reportMembers(cluster *crv1.MySQLCluster) error {
+	pods, err := c.kubeClientset.CoreV1().Pods(cluster.Namespace).
+		List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+
+	members := memberStatuses(pods.Items)
+	if reflect.DeepEqual(cluster.Status.Members, members) {
+		// Nothing changed - skip the update to avoid resource version churn.
+		return nil
+	}
+
+	cluster.Status.Members = members
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// memberStatuses builds the member status entries for the given pods, ordered
+// by ordinal. Pods without an ordinal suffix are skipped.
+func memberStatuses(pods []corev1.Pod) []crv1.MySQLClusterMemberStatus {
+	primary := primaryOrdinal(pods)
+	members := make([]crv1.MySQLClusterMemberStatus, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil {
+			continue
+		}
+
+		role := RoleReplica
+		if ordinal == primary {
+			role = RolePrimary
+		}
+		members = append(members, crv1.MySQLClusterMemberStatus{
+			Name:           pod.Name,
+			Ordinal:        int32(ordinal),
+			Role:           role,
+			Ready:          IsPodReady(pod),
+			ReplicationLag: podReplicationLag(pod),
+		})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Ordinal < members[j].Ordinal
+	})
+	return members
+}
+
+// podReplicationLag parses the replication lag annotation of the given pod,
+// returning -1 when the lag is not published or not a number.
+func podReplicationLag(pod *corev1.Pod) int64 {
+	value, ok := pod.Annotations[ReplicationLagAnnotation]
+	if !ok {
+		return -1
+	}
+
+	lag, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return -1
+	}
+	return lag
+}
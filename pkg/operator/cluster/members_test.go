@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Members", func() {
+	Describe("memberStatuses", func() {
+		It("orders the members by ordinal with the annotated primary first", func() {
+			pods := []corev1.Pod{
+				*readyPodWithRole("my-cluster", 1, RolePrimary),
+				*readyPodWithRole("my-cluster", 0, RoleReplica),
+			}
+
+			members := memberStatuses(pods)
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].Name).To(Equal("my-cluster-0"))
+			Expect(members[0].Role).To(Equal(RoleReplica))
+			Expect(members[1].Name).To(Equal("my-cluster-1"))
+			Expect(members[1].Role).To(Equal(RolePrimary))
+		})
+
+		It("reports readiness and replication lag per pod", func() {
+			lagging := readyPodWithRole("my-cluster", 1, RoleReplica)
+			lagging.Annotations[ReplicationLagAnnotation] = "12"
+			unready := readyPodWithRole("my-cluster", 2, RoleReplica)
+			unready.Status.Conditions = nil
+
+			members := memberStatuses([]corev1.Pod{
+				*readyPodWithRole("my-cluster", 0, RolePrimary),
+				*lagging,
+				*unready,
+			})
+
+			Expect(members[0].Ready).To(BeTrue())
+			Expect(members[0].ReplicationLag).To(Equal(int64(-1)))
+			Expect(members[1].ReplicationLag).To(Equal(int64(12)))
+			Expect(members[2].Ready).To(BeFalse())
+		})
+	})
+
+	Describe("reportMembers", func() {
+		var (
+			cluster  *crv1.MySQLCluster
+			operator *clusterOperator
+		)
+
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			operator = &clusterOperator{
+				clientset: versioned.NewSimpleClientset(cluster),
+				kubeClientset: fake.NewSimpleClientset(
+					readyPodWithRole(cluster.Name, 0, RolePrimary),
+					readyPodWithRole(cluster.Name, 1, RoleReplica),
+				),
+			}
+		})
+
+		It("records the member statuses on the cluster", func() {
+			Expect(operator.reportMembers(cluster)).To(Succeed())
+
+			updated, err := operator.clientset.CrV1().
+				MySQLClusters(cluster.Namespace).Get(cluster.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Status.Members).To(HaveLen(2))
+			Expect(updated.Status.Members[0].Role).To(Equal(RolePrimary))
+			Expect(updated.Status.Members[1].Role).To(Equal(RoleReplica))
+		})
+	})
+})
@@ -0,0 +1,144 @@
+package cluster
+
+import (
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/util"
+)
+
+const jobMigrationTemplate = "artifacts/cluster-job-migration.yaml"
+
+// migrationPollInterval is how often the migration watch checks the Job.
+const migrationPollInterval = 10 * time.Second
+
+// startMigrationGate records the pending Migrated condition, creates the
+// migration Job and watches it in the background. Until the Job succeeds the
+// cluster pods keep their role labels unset, so the read service has no
+// endpoints to route to.
+func (c *clusterOperator) startMigrationGate(cluster *crv1.MySQLCluster) error {
+	cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionFalse,
+		"Waiting for the migration job to succeed")
+	err := c.setClusterState(cluster, "MigrationPending",
+		"Waiting for the migration job before accepting read traffic")
+	if err != nil {
+		return err
+	}
+
+	err = c.createMigrationJob(cluster)
+	if err != nil {
+		return err
+	}
+
+	go c.watchMigration(cluster)
+	return nil
+}
+
+// createMigrationJob creates the migration gate Job from the template.
+func (c *clusterOperator) createMigrationJob(cluster *crv1.MySQLCluster) error {
+	job := new(batchv1.Job)
+	err := util.ObjectFromTemplate(cluster, job, jobMigrationTemplate, FuncMap)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.kubeClientset.BatchV1().Jobs(cluster.Namespace).Create(job)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	} else if apierrors.IsAlreadyExists(err) {
+		logging.LogCluster(cluster).Warn("Migration job already exists")
+	}
+
+	return nil
+}
+
+// watchMigration waits for the migration Job to finish and flips the
+// Migrated condition accordingly. On success the pod roles are labeled right
+// away, so the read service picks the members up without waiting for the
+// next reconcile.
+func (c *clusterOperator) watchMigration(cluster *crv1.MySQLCluster) {
+	var failureMessage string
+
+	err := wait.PollInfinite(migrationPollInterval, func() (bool, error) {
+		job, err := c.kubeClientset.BatchV1().Jobs(cluster.Namespace).
+			Get(MigrationJobName(cluster.ResourceName()), metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		if jobFailed(job) {
+			failureMessage = fmt.Sprintf(
+				"Migration job failed after %d attempts", job.Status.Failed)
+			return true, nil
+		}
+		return job.Status.Succeeded > 0, nil
+	})
+	if err != nil {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Unable to watch the migration job")
+		return
+	}
+
+	if failureMessage != "" {
+		cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionFalse, failureMessage)
+		err = c.setClusterState(cluster, "MigrationFailed", failureMessage)
+	} else {
+		cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionTrue, "")
+		err = c.setClusterState(cluster, "Ready", "Migration complete, read traffic enabled")
+	}
+	if err != nil {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Setting status")
+		return
+	}
+
+	if failureMessage == "" {
+		err = c.attachMigratedCluster(cluster)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to label the pod roles")
+		}
+	}
+}
+
+// attachMigratedCluster labels the pod roles of a freshly migrated cluster,
+// attaching its members to the read service.
+func (c *clusterOperator) attachMigratedCluster(cluster *crv1.MySQLCluster) error {
+	pods, err := c.kubeClientset.CoreV1().Pods(cluster.Namespace).
+		List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+	return c.labelPodRoles(cluster, primaryOrdinal(pods.Items))
+}
+
+// migrationPending reports whether the migration gate still holds the
+// cluster back from accepting read traffic.
+func migrationPending(cluster *crv1.MySQLCluster) bool {
+	if cluster.Spec.MigrationGate == nil {
+		return false
+	}
+	condition := cluster.Condition(crv1.ConditionMigrated)
+	return condition == nil || condition.Status != corev1.ConditionTrue
+}
+
+// jobFailed reports whether the Job has exhausted its retries.
+func jobFailed(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
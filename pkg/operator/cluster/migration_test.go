@@ -0,0 +1,86 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Migration", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Spec.MigrationGate = &crv1.MySQLClusterMigrationGate{
+			Image:   "registry.local/migrate:v3",
+			Command: []string{"migrate", "up"},
+		}
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	Describe("createMigrationJob", func() {
+		It("creates the job from the gate spec", func() {
+			Expect(operator.createMigrationJob(cluster)).To(Succeed())
+
+			job, err := operator.kubeClientset.BatchV1().Jobs(cluster.Namespace).
+				Get(MigrationJobName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("registry.local/migrate:v3"))
+			Expect(container.Command).To(Equal([]string{"migrate", "up"}))
+			Expect(job.OwnerReferences).To(HaveLen(1))
+			Expect(job.OwnerReferences[0].Name).To(Equal(cluster.Name))
+		})
+	})
+
+	Describe("migrationPending", func() {
+		It("is false without a migration gate", func() {
+			cluster.Spec.MigrationGate = nil
+			Expect(migrationPending(cluster)).To(BeFalse())
+		})
+
+		It("is true until the migration succeeds", func() {
+			Expect(migrationPending(cluster)).To(BeTrue())
+
+			cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionFalse, "running")
+			Expect(migrationPending(cluster)).To(BeTrue())
+
+			cluster.SetCondition(crv1.ConditionMigrated, corev1.ConditionTrue, "")
+			Expect(migrationPending(cluster)).To(BeFalse())
+		})
+	})
+
+	Describe("jobFailed", func() {
+		It("reports a job with the Failed condition", func() {
+			job := &batchv1.Job{
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+					},
+				},
+			}
+			Expect(jobFailed(job)).To(BeTrue())
+			Expect(jobFailed(new(batchv1.Job))).To(BeFalse())
+		})
+	})
+})
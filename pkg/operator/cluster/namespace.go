@@ -0,0 +1,20 @@
+package cluster
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// namespaceTerminating returns true when the cluster's namespace is being
+// deleted. Reconciling is pointless then - anything the operator creates is
+// rejected or removed again by the namespace controller.
+func (c *clusterOperator) namespaceTerminating(cluster *crv1.MySQLCluster) bool {
+	namespace, err := c.kubeClientset.CoreV1().Namespaces().
+		Get(cluster.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+	return namespace.Status.Phase == corev1.NamespaceTerminating
+}
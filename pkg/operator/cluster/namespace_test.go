@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Namespace", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("with a terminating namespace", func() {
+		BeforeEach(func() {
+			operator = &clusterOperator{
+				clientset: versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: cluster.Namespace},
+					Status: corev1.NamespaceStatus{
+						Phase: corev1.NamespaceTerminating,
+					},
+				}),
+			}
+		})
+
+		It("is detected", func() {
+			Expect(operator.namespaceTerminating(cluster)).To(BeTrue())
+		})
+
+		It("short-circuits the reconcile", func() {
+			Expect(operator.AddCluster(cluster)).To(Succeed())
+
+			services, err := operator.kubeClientset.CoreV1().
+				Services(cluster.Namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services.Items).To(BeEmpty())
+		})
+	})
+
+	It("is not detected for an active namespace", func() {
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(),
+			kubeClientset: fake.NewSimpleClientset(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: cluster.Namespace},
+				Status: corev1.NamespaceStatus{
+					Phase: corev1.NamespaceActive,
+				},
+			}),
+		}
+		Expect(operator.namespaceTerminating(cluster)).To(BeFalse())
+	})
+})
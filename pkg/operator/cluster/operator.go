@@ -1,24 +1,36 @@
 package cluster
 
 import (
+	"fmt"
+	"strings"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
 
 	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
 	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/config"
 	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/metrics"
+	"github.com/grtl/mysql-operator/pkg/tracing"
 	"github.com/grtl/mysql-operator/pkg/util"
 )
 
 const (
-	serviceTemplate     = "artifacts/cluster-service.yaml"
-	serviceReadTemplate = "artifacts/cluster-service-read.yaml"
-	statefulSetTemplate = "artifacts/cluster-statefulset.yaml"
+	serviceTemplate         = "artifacts/cluster-service.yaml"
+	serviceReadTemplate     = "artifacts/cluster-service-read.yaml"
+	serviceMetricsTemplate  = "artifacts/cluster-service-metrics.yaml"
+	statefulSetTemplate     = "artifacts/cluster-statefulset.yaml"
+	configMapInitTemplate   = "artifacts/cluster-configmap-init.yaml"
+	configMapConfigTemplate = "artifacts/cluster-configmap-config.yaml"
 )
 
 // Operator represents an object to manipulate MySQLCluster custom resources.
@@ -26,11 +38,38 @@ type Operator interface {
 	// AddCluster creates the Kubernetes API objects necessary for a MySQL cluster.
 	AddCluster(cluster *crv1.MySQLCluster) error
 	UpdateCluster(newCluster *crv1.MySQLCluster) error
+	// DeleteCluster tears the cluster's services and stateful set down.
+	// Already removed resources are tolerated.
+	DeleteCluster(cluster *crv1.MySQLCluster) error
+	// FinalizeCluster cleans up after a cluster being deleted and removes the
+	// operator's finalizer.
+	FinalizeCluster(cluster *crv1.MySQLCluster) error
+	// CheckSplitBrain detects multiple writable members and optionally
+	// fences all but the intended primary.
+	CheckSplitBrain(cluster *crv1.MySQLCluster) error
+	// CheckNodeMaintenance switches the primary over to a replica on a
+	// schedulable node when the primary's node is cordoned.
+	CheckNodeMaintenance(cluster *crv1.MySQLCluster) error
+	// CheckStoragePressure flags clusters whose data volume usage crossed
+	// the configured threshold.
+	CheckStoragePressure(cluster *crv1.MySQLCluster) error
+	// CheckZoneAffinity flags clusters with a member pod running in a
+	// different zone than its bound data volume.
+	CheckZoneAffinity(cluster *crv1.MySQLCluster) error
+	// RecommendResources records an advisory right-sizing hint computed
+	// from observed usage in the cluster status.
+	RecommendResources(cluster *crv1.MySQLCluster) error
 }
 
 type clusterOperator struct {
 	clientset     versioned.Interface
 	kubeClientset kubernetes.Interface
+	// serviceMonitorClient is optional - when nil, ServiceMonitor creation is
+	// skipped.
+	serviceMonitorClient dynamic.Interface
+	// restConfig is optional - when nil, features which exec into pods are
+	// skipped.
+	restConfig *rest.Config
 }
 
 // NewClusterOperator returns a new Operator.
@@ -41,11 +80,67 @@ func NewClusterOperator(clientset versioned.Interface, kubeClientset kubernetes.
 	}
 }
 
-func (c *clusterOperator) AddCluster(cluster *crv1.MySQLCluster) error {
+// spanForCluster starts a trace span for the named reconcile operation with
+// the cluster identity attached.
+func spanForCluster(name string, cluster *crv1.MySQLCluster) *tracing.Span {
+	span := tracing.StartSpan(name)
+	span.SetAttribute("cluster", cluster.Name)
+	span.SetAttribute("namespace", cluster.Namespace)
+	return span
+}
+
+func (c *clusterOperator) AddCluster(cluster *crv1.MySQLCluster) (err error) {
+	span := spanForCluster("AddCluster", cluster)
+	defer func() { span.RecordError(err); span.End() }()
+
+	if c.namespaceTerminating(cluster) {
+		logging.LogCluster(cluster).Info("Namespace is terminating, skipping reconcile.")
+		return nil
+	}
+
 	cluster.WithDefaults()
+	err = cluster.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = c.checkStorageClass(cluster)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkCustomConfig(cluster)
+	if err != nil {
+		return err
+	}
+
+	if isDryRun(cluster) {
+		logging.LogCluster(cluster).Info("Dry run - reporting diff only.")
+		return c.reportDryRunDiff(cluster)
+	}
+
+	logging.LogCluster(cluster).Debug("Ensuring finalizer.")
+	err = c.ensureFinalizer(cluster)
+	if err != nil {
+		return err
+	}
+
+	if name, ok := adoptionRequested(cluster); ok {
+		logging.LogCluster(cluster).Info("Adopting existing resources.")
+		err = c.adoptResources(cluster, name)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Setting status")
+			setStateErr := c.setClusterState(cluster, "Failed adoption", err.Error())
+			return errors.NewAggregate([]error{err, setStateErr})
+		}
+		// Reconcile the adopted resources toward the spec instead of
+		// creating new ones.
+		return c.UpdateCluster(cluster)
+	}
 
 	logging.LogCluster(cluster).Debug("Creating service.")
-	err := c.createService(cluster, serviceTemplate)
+	err = c.createService(cluster, serviceTemplate)
 	if err != nil {
 		return err
 	}
@@ -60,10 +155,61 @@ func (c *clusterOperator) AddCluster(cluster *crv1.MySQLCluster) error {
 		return errors.NewAggregate([]error{err, removeErr})
 	}
 
+	if cluster.Spec.Metrics != nil {
+		logging.LogCluster(cluster).Debug("Creating metrics service.")
+		err = c.createService(cluster, serviceMetricsTemplate)
+		if err != nil {
+			// Cleanup - remove already created services
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Reverting service creation.")
+			removeErr := c.removeService(cluster)
+			err = errors.NewAggregate([]error{err, removeErr})
+
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Reverting read service creation.")
+			removeErr = c.removeReadService(cluster)
+			return errors.NewAggregate([]error{err, removeErr})
+		}
+
+		if cluster.Spec.Metrics.ServiceMonitor {
+			logging.LogCluster(cluster).Debug("Creating service monitor.")
+			err = c.createServiceMonitor(cluster)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if cluster.Spec.Proxy != nil {
+		logging.LogCluster(cluster).Debug("Creating the connection pooler.")
+		err = c.ensureProxy(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cluster.Spec.InitScript != nil && cluster.Spec.InitScript.Inline != "" {
+		logging.LogCluster(cluster).Debug("Creating init script config map.")
+		err = c.createInitScriptConfigMap(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(cluster.Spec.Config) > 0 {
+		logging.LogCluster(cluster).Debug("Creating custom config map.")
+		err = c.createConfigConfigMap(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
 	logging.LogCluster(cluster).Debug("Creating stateful set.")
 	err = c.createStatefulSet(cluster)
 	if err != nil {
 		// Cleanup - remove already created services
+		c.recordEvent(cluster, corev1.EventTypeWarning, "Rollback",
+			"StatefulSet creation failed; the created services were removed")
 		logging.LogCluster(cluster).WithField(
 			"fail", err).Warn("Reverting service creation.")
 		removeErr := c.removeService(cluster)
@@ -75,14 +221,74 @@ func (c *clusterOperator) AddCluster(cluster *crv1.MySQLCluster) error {
 		return errors.NewAggregate([]error{err, removeErr})
 	}
 
+	if len(cluster.Spec.ExtraManifests) > 0 {
+		logging.LogCluster(cluster).Debug("Applying extra manifests.")
+		err = c.applyExtraManifests(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(cluster.Spec.BackupSchedules) > 0 {
+		logging.LogCluster(cluster).Debug("Creating inline backup schedules.")
+		err = c.reconcileInlineSchedules(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cluster.Spec.MigrationGate != nil {
+		logging.LogCluster(cluster).Debug("Starting the migration gate.")
+		err = c.startMigrationGate(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cluster.Spec.BootstrapTimeoutSeconds > 0 {
+		logging.LogCluster(cluster).Debug("Watching the cluster bootstrap.")
+		go c.watchBootstrap(cluster)
+	}
+
+	c.recordAudit(cluster, "Create", fmt.Sprintf(
+		"Cluster resources created with %d replicas and image %s",
+		cluster.Spec.Replicas, cluster.Spec.Image))
+
 	return nil
 }
 
-func (c *clusterOperator) UpdateCluster(newCluster *crv1.MySQLCluster) error {
+func (c *clusterOperator) UpdateCluster(newCluster *crv1.MySQLCluster) (err error) {
+	span := spanForCluster("UpdateCluster", newCluster)
+	defer func() { span.RecordError(err); span.End() }()
+
+	if c.namespaceTerminating(newCluster) {
+		logging.LogCluster(newCluster).Info("Namespace is terminating, skipping reconcile.")
+		return nil
+	}
+
 	newCluster.WithDefaults()
+	err = newCluster.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = c.checkStorageClass(newCluster)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkCustomConfig(newCluster)
+	if err != nil {
+		return err
+	}
+
+	if isDryRun(newCluster) {
+		logging.LogCluster(newCluster).Info("Dry run - reporting diff only.")
+		return c.reportDryRunDiff(newCluster)
+	}
 
 	logging.LogCluster(newCluster).Debug("Updating services.")
-	err := c.updateServices(newCluster)
+	err = c.updateServices(newCluster)
 	if err != nil {
 		logging.LogCluster(newCluster).WithField(
 			"error", err).Warn("Setting status")
@@ -94,8 +300,125 @@ func (c *clusterOperator) UpdateCluster(newCluster *crv1.MySQLCluster) error {
 		return errors.NewAggregate([]error{err, setStateErr})
 	}
 
+	if newCluster.Spec.Proxy != nil {
+		logging.LogCluster(newCluster).Debug("Ensuring the connection pooler.")
+		err = c.ensureProxy(newCluster)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Unable to reconcile the connection pooler")
+		}
+	}
+
+	if len(newCluster.Spec.Config) > 0 {
+		logging.LogCluster(newCluster).Debug("Converging the custom config map.")
+		err = c.createConfigConfigMap(newCluster)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Unable to converge the custom config map")
+		}
+	}
+
+	if newCluster.Spec.AllowRecreate {
+		logging.LogCluster(newCluster).Debug("Checking for immutable field changes.")
+		field, err := c.recreateStatefulSetIfNeeded(newCluster)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Setting status")
+			setStateErr := c.setClusterState(
+				newCluster,
+				"Failed update",
+				"Unable to recreate the StatefulSet for an immutable field change",
+			)
+			return errors.NewAggregate([]error{err, setStateErr})
+		}
+		if field != "" {
+			return c.setClusterState(
+				newCluster,
+				"Recreated",
+				fmt.Sprintf("StatefulSet recreated for a change of the immutable field %q", field),
+			)
+		}
+	} else {
+		// A stale serviceName breaks pod DNS and degrades the cluster - check
+		// for it first so it is not reported as a plain failed update.
+		logging.LogCluster(newCluster).Debug("Checking stateful set service name.")
+		err = c.checkStatefulSetServiceName(newCluster)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Setting status")
+			setStateErr := c.setDegraded(newCluster, err.Error())
+			return errors.NewAggregate([]error{err, setStateErr})
+		}
+
+		logging.LogCluster(newCluster).Debug("Checking for immutable field changes.")
+		field, checkErr := c.changedImmutableField(newCluster)
+		if checkErr != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", checkErr).Warn("Unable to check for immutable field changes")
+		}
+		if field != "" {
+			// The update cannot be applied in place - fail with the field
+			// name instead of a raw API error from the update.
+			return c.setClusterState(
+				newCluster,
+				"Failed update",
+				fmt.Sprintf("The immutable field %q cannot be updated in place, set allowRecreate to recreate the StatefulSet", field),
+			)
+		}
+	}
+
+	logging.LogCluster(newCluster).Debug("Checking scale-down safety.")
+	err = c.checkScaleDown(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Setting status")
+		setStateErr := c.setDegraded(newCluster, err.Error())
+		return errors.NewAggregate([]error{err, setStateErr})
+	}
+
+	logging.LogCluster(newCluster).Debug("Recording update order.")
+	primary, err := c.recordUpdateOrder(newCluster)
+	if err != nil {
+		// Fall back to the default order with the primary at ordinal 0.
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to record the update order")
+		primary = 0
+	}
+
+	if migrationPending(newCluster) {
+		logging.LogCluster(newCluster).Debug(
+			"Migration pending - leaving the pod roles unlabeled.")
+	} else {
+		logging.LogCluster(newCluster).Debug("Labeling pod roles.")
+		err = c.labelPodRoles(newCluster, primary)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Unable to label the pod roles")
+		}
+	}
+
+	err = c.drainScaleDown(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to drain the removed replicas")
+	}
+
+	logging.LogCluster(newCluster).Debug("Reconciling the read/write split.")
+	err = c.reconcileReadWriteSplit(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to reconcile the read/write split")
+	}
+
+	// Snapshot the live StatefulSet for the audit trail before the update.
+	live, liveErr := c.kubeClientset.AppsV1().StatefulSets(newCluster.Namespace).
+		Get(StatefulSetName(newCluster.ResourceName()), metav1.GetOptions{})
+	if liveErr != nil {
+		live = nil
+	}
+
 	logging.LogCluster(newCluster).Debug("Updating stateful set.")
-	err = c.updateStatefulSet(newCluster)
+	err = c.updateStatefulSet(newCluster, primary)
 	if err != nil {
 		logging.LogCluster(newCluster).WithField(
 			"fail", err).Warn("Setting status")
@@ -107,19 +430,128 @@ func (c *clusterOperator) UpdateCluster(newCluster *crv1.MySQLCluster) error {
 		return errors.NewAggregate([]error{err, setStateErr})
 	}
 
-	return c.setClusterState(newCluster, "Successful update", "")
+	if limit := config.Get().RevisionGCLimit; limit > 0 {
+		logging.LogCluster(newCluster).Debug("Pruning old controller revisions.")
+		err = c.gcControllerRevisions(newCluster, limit)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Unable to prune the old controller revisions")
+		}
+	}
+
+	if len(newCluster.Spec.ExtraManifests) > 0 {
+		logging.LogCluster(newCluster).Debug("Applying extra manifests.")
+		err = c.applyExtraManifests(newCluster)
+		if err != nil {
+			logging.LogCluster(newCluster).WithField(
+				"fail", err).Warn("Setting status")
+			setStateErr := c.setDegraded(newCluster, err.Error())
+			return errors.NewAggregate([]error{err, setStateErr})
+		}
+	}
+
+	logging.LogCluster(newCluster).Debug("Reconciling inline backup schedules.")
+	err = c.reconcileInlineSchedules(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to reconcile the inline backup schedules")
+	}
+
+	clearUnhealthy(newCluster)
+	err = c.setClusterState(newCluster, "Successful update", "")
+	if err != nil {
+		return err
+	}
+
+	// Audit only updates which actually changed something, so a no-op
+	// reconcile does not grow the audit log.
+	if summary := updateSummary(live, newCluster); summary != "" {
+		c.recordAudit(newCluster, "Update", summary)
+	}
+
+	logging.LogCluster(newCluster).Debug("Handling backup-now annotation.")
+	err = c.handleBackupNow(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to create the requested backup")
+	}
+
+	logging.LogCluster(newCluster).Debug("Applying read-only setting.")
+	err = c.applyReadOnly(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to apply the read-only setting")
+	}
+
+	logging.LogCluster(newCluster).Debug("Reporting running version.")
+	err = c.reportRunningVersion(newCluster)
+	if err != nil {
+		// Version reporting is best effort - the update itself succeeded.
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to report the running version")
+	}
+
+	logging.LogCluster(newCluster).Debug("Reporting member statuses.")
+	err = c.reportMembers(newCluster)
+	if err != nil {
+		logging.LogCluster(newCluster).WithField(
+			"fail", err).Warn("Unable to report the member statuses")
+	}
+
+	return nil
+}
+
+// DeleteCluster tears the cluster's services and stateful set down. Every
+// removal is attempted even when an earlier one fails, and missing resources
+// are tolerated, so a partially removed cluster still deletes cleanly.
+func (c *clusterOperator) DeleteCluster(cluster *crv1.MySQLCluster) (err error) {
+	span := spanForCluster("DeleteCluster", cluster)
+	defer func() { span.RecordError(err); span.End() }()
+
+	var errs []error
+	for _, remove := range []func(*crv1.MySQLCluster) error{
+		c.removeService,
+		c.removeReadService,
+		c.removeStatefulSet,
+	} {
+		removeErr := remove(cluster)
+		if removeErr != nil && !apierrors.IsNotFound(removeErr) {
+			errs = append(errs, removeErr)
+		}
+	}
+
+	return errors.NewAggregate(errs)
 }
 
 func (c *clusterOperator) setClusterState(cluster *crv1.MySQLCluster, state, message string) error {
+	// Surface state transitions as Events, so they show up in
+	// `kubectl describe`. Re-asserting the same state stays quiet.
+	if cluster.Status.State != state {
+		eventType := corev1.EventTypeNormal
+		if phase := phaseForState(state); phase == metrics.PhaseFailed || phase == metrics.PhaseDegraded {
+			eventType = corev1.EventTypeWarning
+		}
+		eventMessage := state
+		if message != "" {
+			eventMessage = fmt.Sprintf("%s: %s", state, message)
+		}
+		c.recordEvent(cluster, eventType, "StateChange", eventMessage)
+	}
+
 	cluster.Status.State = state
 	cluster.Status.Message = message
+	metrics.SetClusterPhase(cluster.Namespace, cluster.Name, phaseForState(state))
 	_, updateErr := c.clientset.CrV1().
 		MySQLClusters(cluster.ObjectMeta.Namespace).Update(cluster)
 
 	return updateErr
 }
 
-func (c *clusterOperator) createService(cluster *crv1.MySQLCluster, filename string) error {
+func (c *clusterOperator) createService(cluster *crv1.MySQLCluster, filename string) (err error) {
+	span := spanForCluster("createService", cluster)
+	span.SetAttribute("template", filename)
+	defer func() { span.RecordError(err); span.End() }()
+
 	serviceInterface := c.kubeClientset.CoreV1().Services(cluster.Namespace)
 	service, err := serviceForCluster(cluster, filename)
 	if err != nil {
@@ -127,20 +559,61 @@ func (c *clusterOperator) createService(cluster *crv1.MySQLCluster, filename str
 	}
 
 	_, err = serviceInterface.Create(service)
+	if apierrors.IsAlreadyExists(err) {
+		// The service survived from a previous incarnation - reconcile it
+		// toward the rendered spec instead of assuming it still matches.
+		logging.LogCluster(cluster).Debug("Service for cluster already exists, reconciling.")
+		return reconcileExistingService(serviceInterface, service)
+	}
+	if err == nil {
+		c.recordEvent(cluster, corev1.EventTypeNormal, "ServiceCreated",
+			fmt.Sprintf("Service %s created", service.Name))
+	}
+
+	return err
+}
+
+// reconcileExistingService converges a service which already existed when the
+// cluster was added toward the freshly rendered spec, so drift accumulated
+// while the operator was down is corrected instead of ignored.
+func reconcileExistingService(serviceInterface typedv1.ServiceInterface, desired *corev1.Service) error {
+	live, err := serviceInterface.Get(desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	merged := applyService(live, desired)
+	if equality.Semantic.DeepEqual(merged, live) {
+		return nil
+	}
+
+	_, err = serviceInterface.Update(merged)
+	return err
+}
+
+func (c *clusterOperator) createInitScriptConfigMap(cluster *crv1.MySQLCluster) error {
+	configMapInterface := c.kubeClientset.CoreV1().ConfigMaps(cluster.Namespace)
+	configMap := new(corev1.ConfigMap)
+	err := util.ObjectFromTemplate(cluster, configMap, configMapInitTemplate, FuncMap)
+	if err != nil {
+		return err
+	}
+
+	_, err = configMapInterface.Create(configMap)
 	if err != nil && !apierrors.IsAlreadyExists(err) {
 		return err
 	} else if apierrors.IsAlreadyExists(err) {
-		logging.LogCluster(cluster).Warn("Service for cluster already exists")
+		logging.LogCluster(cluster).Warn("Init script config map already exists")
 	}
 
 	return nil
 }
 
-func (c *clusterOperator) createStatefulSet(cluster *crv1.MySQLCluster) error {
-	var (
-		backup *crv1.MySQLBackupInstance
-		err    error
-	)
+func (c *clusterOperator) createStatefulSet(cluster *crv1.MySQLCluster) (err error) {
+	span := spanForCluster("createStatefulSet", cluster)
+	defer func() { span.RecordError(err); span.End() }()
+
+	var backup *crv1.MySQLBackupInstance
 
 	// If we're creating cluster for backup fetch the backup
 	if cluster.Spec.FromBackup != "" {
@@ -149,8 +622,30 @@ func (c *clusterOperator) createStatefulSet(cluster *crv1.MySQLCluster) error {
 		if err != nil {
 			return err
 		}
+
+		err = c.checkRestoreCompatibility(cluster, backup)
+		if err != nil {
+			setStateErr := c.setClusterState(cluster, "Incompatible restore", err.Error())
+			return errors.NewAggregate([]error{err, setStateErr})
+		}
+
+		// Record which overwrite policy and database subset the restore runs
+		// with; its outcome shows up as the cluster state via the bootstrap
+		// watch.
+		cluster.Status.RestorePolicy = cluster.Spec.RestoreOverwritePolicy
+		cluster.Status.RestoredDatabases = cluster.Spec.RestoreDatabases
+		summary := fmt.Sprintf(
+			"Restoring from backup %q with overwrite policy %q",
+			cluster.Spec.FromBackup, cluster.Spec.RestoreOverwritePolicy)
+		if len(cluster.Spec.RestoreDatabases) > 0 {
+			summary += fmt.Sprintf(
+				", limited to databases: %s", strings.Join(cluster.Spec.RestoreDatabases, ", "))
+		}
+		c.recordAudit(cluster, "Restore", summary)
 	}
 
+	c.warnPVCRetentionUnsupported(cluster)
+
 	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
 	statefulSet, err := statefulSetForCluster(cluster, backup)
 	if err != nil {
@@ -158,59 +653,217 @@ func (c *clusterOperator) createStatefulSet(cluster *crv1.MySQLCluster) error {
 	}
 
 	_, err = statefulSetInterface.Create(statefulSet)
-	if err != nil && !apierrors.IsAlreadyExists(err) {
+	if apierrors.IsAlreadyExists(err) {
+		// The stateful set survived from a previous incarnation - reconcile
+		// it toward the rendered spec instead of assuming it still matches.
+		logging.LogCluster(cluster).Debug("StatefulSet for cluster already exists, reconciling.")
+		live, getErr := statefulSetInterface.Get(statefulSet.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		if !statefulSetUnchanged(statefulSet, live) {
+			_, err = statefulSetInterface.Update(applyStatefulSet(live, statefulSet))
+			if err != nil {
+				return err
+			}
+		}
+		return c.applyUnsupportedFields(cluster)
+	}
+	if err != nil {
 		return err
-	} else if apierrors.IsAlreadyExists(err) {
-		logging.LogCluster(cluster).Warn("StatefulSet for cluster already exists")
 	}
 
-	return nil
+	c.recordEvent(cluster, corev1.EventTypeNormal, "StatefulSetCreated",
+		fmt.Sprintf("StatefulSet %s created", statefulSet.Name))
+	return c.applyUnsupportedFields(cluster)
 }
 
 func (c *clusterOperator) updateServices(cluster *crv1.MySQLCluster) error {
 	serviceInterface := c.kubeClientset.CoreV1().Services(cluster.Namespace)
 
-	err := updateService(cluster, serviceInterface, serviceTemplate)
+	prior, err := updateService(cluster, serviceInterface, serviceTemplate)
 	if err != nil {
 		return err
 	}
 
-	return updateService(cluster, serviceInterface, serviceReadTemplate)
+	_, err = updateService(cluster, serviceInterface, serviceReadTemplate)
+	if err != nil {
+		// Mirror the AddCluster rollback - restore the already updated write
+		// service, so the pair does not stay half updated.
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Reverting the service update.")
+		restoreErr := restoreService(serviceInterface, prior)
+		c.recordAudit(cluster, "Rollback",
+			"Read service update failed; the write service was restored to its previous state")
+		return errors.NewAggregate([]error{err, restoreErr})
+	}
+
+	if cluster.Spec.Metrics != nil {
+		_, err = updateService(cluster, serviceInterface, serviceMetricsTemplate)
+		return err
+	}
+
+	return nil
 }
 
-func updateService(cluster *crv1.MySQLCluster, serviceInterface typedv1.ServiceInterface, template string) error {
+// updateService reconciles the service rendered from the template and returns
+// the service's prior live state, so a caller can restore it when a
+// subsequent step fails.
+func updateService(cluster *crv1.MySQLCluster, serviceInterface typedv1.ServiceInterface, template string) (*corev1.Service, error) {
 	service, err := serviceForCluster(cluster, template)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Hack! At the moment, when updating a Service, the API will complain about
-	// resourceVersion not being set. This field is documented as read-only.
-	// Setting it manually like this based on the previous value is a workaround
-	// that allows us to update.
-	oldService, err := serviceInterface.Get(service.ObjectMeta.Name, metav1.GetOptions{})
-	service.ObjectMeta.ResourceVersion = oldService.ObjectMeta.ResourceVersion
+	live, err := serviceInterface.Get(service.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	prior := live.DeepCopy()
 
-	_, err = serviceInterface.Update(service)
+	// Update only the fields the operator owns, so changes made by other
+	// tools survive the reconcile. This also carries over the read-only
+	// resourceVersion and the allocated clusterIP.
+	_, err = serviceInterface.Update(applyService(live, service))
+	if err != nil {
+		return nil, err
+	}
 
+	return prior, nil
+}
+
+// restoreService puts the service's prior state back after a failed update of
+// its sibling service. The prior object's owned fields are re-applied onto
+// the current live state, so concurrent changes by other tools are kept.
+func restoreService(serviceInterface typedv1.ServiceInterface, prior *corev1.Service) error {
+	live, err := serviceInterface.Get(prior.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = serviceInterface.Update(applyService(live, prior))
 	return err
 }
 
-func (c *clusterOperator) updateStatefulSet(cluster *crv1.MySQLCluster) error {
+// checkStatefulSetServiceName detects a stateful set whose immutable
+// serviceName no longer matches the cluster's headless service. Such a
+// stateful set breaks pod DNS and cannot be patched - it has to be recreated.
+func (c *clusterOperator) checkStatefulSetServiceName(cluster *crv1.MySQLCluster) error {
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	statefulSet, err := statefulSetInterface.Get(StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if statefulSet.Spec.ServiceName != ServiceName(cluster.ResourceName()) {
+		return fmt.Errorf(
+			"stateful set %q is governed by service %q instead of %q; "+
+				"serviceName is immutable, delete the stateful set to have it recreated",
+			statefulSet.Name, statefulSet.Spec.ServiceName, ServiceName(cluster.ResourceName()))
+	}
+
+	return nil
+}
+
+func (c *clusterOperator) updateStatefulSet(cluster *crv1.MySQLCluster, primary int) error {
 	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
 	statefulSet, err := statefulSetForCluster(cluster, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = statefulSetInterface.Update(statefulSet)
-	return err
+	if primary > 0 && cluster.Spec.UpdateStrategy != crv1.UpdateOnDelete {
+		// Hold back pods up to and including the primary so the rolling
+		// update processes the replicas first. The partition is lowered once
+		// the primary has been switched over. OnDelete has no partitions -
+		// the pods roll only as they are deleted.
+		partition := int32(primary + 1)
+		statefulSet.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+				Partition: &partition,
+			},
+		}
+	}
+
+	live, err := statefulSetInterface.Get(statefulSet.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if statefulSetUnchanged(statefulSet, live) {
+		logging.LogCluster(cluster).Debug("StatefulSet unchanged, skipping update.")
+		return nil
+	}
+
+	if cluster.Spec.LameDuckSeconds > 0 {
+		logging.LogCluster(cluster).Debug("Rolling the update out pod by pod.")
+		err = c.rollWithLameDuck(cluster, statefulSet)
+		if err != nil {
+			return err
+		}
+		return c.applyUnsupportedFields(cluster)
+	}
+
+	// Update only the fields the operator owns, so changes made by other
+	// tools survive the reconcile.
+	_, err = statefulSetInterface.Update(applyStatefulSet(live, statefulSet))
+	if err != nil {
+		return err
+	}
+	// The typed update drops the readiness gates and minReadySeconds -
+	// re-apply them.
+	return c.applyUnsupportedFields(cluster)
+}
+
+// statefulSetUnchanged returns true when the rendered desired stateful set
+// matches the live object on the fields the operator manages, so resyncs
+// don't generate needless controller revisions.
+func statefulSetUnchanged(desired, live *appsv1.StatefulSet) bool {
+	return equality.Semantic.DeepEqual(desired.Spec.Replicas, live.Spec.Replicas) &&
+		equality.Semantic.DeepEqual(desired.Spec.Template, live.Spec.Template) &&
+		equality.Semantic.DeepEqual(desired.Spec.UpdateStrategy, live.Spec.UpdateStrategy)
 }
 
 func serviceForCluster(cluster *crv1.MySQLCluster, filename string) (*corev1.Service, error) {
 	service := new(corev1.Service)
 	err := util.ObjectFromTemplate(cluster, service, filename, FuncMap)
-	return service, err
+	if err != nil {
+		return nil, err
+	}
+
+	// Cloud load balancer provisioning is driven by service annotations.
+	var annotations map[string]string
+	switch filename {
+	case serviceTemplate:
+		annotations = cluster.Spec.WriteServiceAnnotations
+	case serviceReadTemplate:
+		annotations = cluster.Spec.ReadServiceAnnotations
+	}
+	for key, value := range annotations {
+		if service.Annotations == nil {
+			service.Annotations = map[string]string{}
+		}
+		service.Annotations[key] = value
+	}
+
+	if filename == serviceTemplate && cluster.Spec.LoadBalancerIP != "" {
+		service.Spec.Type = corev1.ServiceTypeLoadBalancer
+		// A LoadBalancer service cannot stay headless - let the cluster IP
+		// be allocated.
+		service.Spec.ClusterIP = ""
+		service.Spec.LoadBalancerIP = cluster.Spec.LoadBalancerIP
+		if cluster.Spec.LoadBalancerClass != nil {
+			// The vendored client libraries predate loadBalancerClass, so
+			// the field cannot be sent to the server.
+			logging.LogCluster(cluster).Warn(
+				"loadBalancerClass is not supported by the client libraries, using the default implementation")
+		}
+	}
+
+	return service, nil
 }
 
 func statefulSetForCluster(cluster *crv1.MySQLCluster, backup *crv1.MySQLBackupInstance) (*appsv1.StatefulSet, error) {
@@ -222,20 +875,71 @@ func statefulSetForCluster(cluster *crv1.MySQLCluster, backup *crv1.MySQLBackupI
 		cluster,
 		backup,
 	}, statefulSet, statefulSetTemplate, FuncMap)
-	return statefulSet, err
+	if err != nil {
+		return nil, err
+	}
+
+	// Descriptive labels go onto the pod template only - the selector is
+	// immutable and stays with the labels the operator renders.
+	for key, value := range cluster.Spec.PodLabels {
+		if statefulSet.Spec.Template.Labels == nil {
+			statefulSet.Spec.Template.Labels = map[string]string{}
+		}
+		statefulSet.Spec.Template.Labels[key] = value
+	}
+
+	applyExtraMounts(cluster, statefulSet)
+
+	return statefulSet, nil
+}
+
+// applyExtraMounts adds the spec's extra config map and secret mounts to the
+// mysql container. The volume names carry the source kind, so two sources
+// with the same name cannot clash.
+func applyExtraMounts(cluster *crv1.MySQLCluster, statefulSet *appsv1.StatefulSet) {
+	addMount := func(volume corev1.Volume, mountPath string) {
+		spec := &statefulSet.Spec.Template.Spec
+		spec.Volumes = append(spec.Volumes, volume)
+		for i := range spec.Containers {
+			if spec.Containers[i].Name != "mysql" {
+				continue
+			}
+			spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts,
+				corev1.VolumeMount{Name: volume.Name, MountPath: mountPath})
+		}
+	}
+
+	for _, mount := range cluster.Spec.ExtraConfigMapMounts {
+		addMount(corev1.Volume{
+			Name: "extra-config-" + mount.Name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: mount.Name},
+				},
+			},
+		}, mount.MountPath)
+	}
+	for _, mount := range cluster.Spec.ExtraSecretMounts {
+		addMount(corev1.Volume{
+			Name: "extra-secret-" + mount.Name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: mount.Name},
+			},
+		}, mount.MountPath)
+	}
 }
 
 func (c *clusterOperator) removeService(cluster *crv1.MySQLCluster) error {
 	serviceInterface := c.kubeClientset.CoreV1().Services(cluster.Namespace)
-	return serviceInterface.Delete(ServiceName(cluster.Name), new(metav1.DeleteOptions))
+	return serviceInterface.Delete(ServiceName(cluster.ResourceName()), new(metav1.DeleteOptions))
 }
 
 func (c *clusterOperator) removeReadService(cluster *crv1.MySQLCluster) error {
 	serviceInterface := c.kubeClientset.CoreV1().Services(cluster.Namespace)
-	return serviceInterface.Delete(ReadServiceName(cluster.Name), new(metav1.DeleteOptions))
+	return serviceInterface.Delete(ReadServiceName(cluster.ResourceName()), new(metav1.DeleteOptions))
 }
 
 func (c *clusterOperator) removeStatefulSet(cluster *crv1.MySQLCluster) error {
 	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
-	return statefulSetInterface.Delete(StatefulSetName(cluster.Name), new(metav1.DeleteOptions))
+	return statefulSetInterface.Delete(StatefulSetName(cluster.ResourceName()), new(metav1.DeleteOptions))
 }
@@ -43,6 +43,236 @@ var _ = Describe("Operator", func() {
 		})
 	})
 
+	Describe("statefulSetForCluster with a custom scheduler", func() {
+		It("sets the scheduler name on the pod spec", func() {
+			cluster.Spec.SchedulerName = "gang-scheduler"
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.Template.Spec.SchedulerName).To(
+				Equal("gang-scheduler"))
+		})
+	})
+
+	Describe("statefulSetForCluster with an update strategy", func() {
+		It("renders the OnDelete strategy", func() {
+			cluster.Spec.UpdateStrategy = crv1.UpdateOnDelete
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.UpdateStrategy.Type).To(
+				BeEquivalentTo(appsv1.OnDeleteStatefulSetStrategyType))
+		})
+
+		It("leaves the strategy to the StatefulSet default when unset", func() {
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.UpdateStrategy.Type).To(
+				BeEquivalentTo(""))
+		})
+	})
+
+	Describe("statefulSetForCluster with host networking", func() {
+		It("runs the pods on the host network with the matching DNS policy", func() {
+			hostNetwork := true
+			cluster.Spec.HostNetwork = &hostNetwork
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.Template.Spec.HostNetwork).To(BeTrue())
+			Expect(statefulSet.Spec.Template.Spec.DNSPolicy).To(
+				Equal(corev1.DNSClusterFirstWithHostNet))
+		})
+
+		It("keeps the pods off the host network by default", func() {
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.Template.Spec.HostNetwork).To(BeFalse())
+		})
+	})
+
+	Describe("statefulSetForCluster with a replica startup barrier", func() {
+		It("injects the wait-primary init container", func() {
+			cluster.Spec.ReplicaStartupTimeoutSeconds = 120
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			names := make([]string, 0, len(statefulSet.Spec.Template.Spec.InitContainers))
+			for _, container := range statefulSet.Spec.Template.Spec.InitContainers {
+				names = append(names, container.Name)
+			}
+			Expect(names).To(ContainElement("wait-primary"))
+		})
+
+		It("omits the barrier when the timeout is zero", func() {
+			cluster.Spec.ReplicaStartupTimeoutSeconds = 0
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, container := range statefulSet.Spec.Template.Spec.InitContainers {
+				Expect(container.Name).NotTo(Equal("wait-primary"))
+			}
+		})
+	})
+
+	Describe("statefulSetForCluster with a DNS config", func() {
+		It("renders the resolver options and search domains", func() {
+			cluster.Spec.DNSConfig = &crv1.MySQLClusterDNSConfig{
+				Options:  []crv1.MySQLClusterDNSOption{{Name: "ndots", Value: "2"}},
+				Searches: []string{"db.example.com"},
+			}
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			dnsConfig := statefulSet.Spec.Template.Spec.DNSConfig
+			Expect(dnsConfig).NotTo(BeNil())
+			Expect(dnsConfig.Searches).To(Equal([]string{"db.example.com"}))
+			Expect(dnsConfig.Options).To(HaveLen(1))
+			Expect(dnsConfig.Options[0].Name).To(Equal("ndots"))
+			Expect(*dnsConfig.Options[0].Value).To(Equal("2"))
+		})
+
+		It("omits the dnsConfig when opted out with an empty config", func() {
+			cluster.Spec.DNSConfig = new(crv1.MySQLClusterDNSConfig)
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statefulSet.Spec.Template.Spec.DNSConfig).To(BeNil())
+		})
+	})
+
+	Describe("statefulSetForCluster with a time zone", func() {
+		mysqlEnv := func(statefulSet *appsv1.StatefulSet) []corev1.EnvVar {
+			for _, container := range statefulSet.Spec.Template.Spec.Containers {
+				if container.Name == "mysql" {
+					return container.Env
+				}
+			}
+			return nil
+		}
+
+		initScript := func(statefulSet *appsv1.StatefulSet) string {
+			return statefulSet.Spec.Template.Spec.InitContainers[0].Command[2]
+		}
+
+		It("passes an offset straight to mysqld", func() {
+			cluster.Spec.TimeZone = "+02:00"
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mysqlEnv(statefulSet)).To(ContainElement(
+				corev1.EnvVar{Name: "TZ", Value: "+02:00"}))
+			Expect(initScript(statefulSet)).To(
+				ContainSubstring("default-time-zone=+02:00"))
+		})
+
+		It("routes a named zone through the container zone", func() {
+			cluster.Spec.TimeZone = "Europe/Warsaw"
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mysqlEnv(statefulSet)).To(ContainElement(
+				corev1.EnvVar{Name: "TZ", Value: "Europe/Warsaw"}))
+			// Named zones need the time zone tables loaded, so mysqld
+			// follows the container zone instead of the name.
+			Expect(initScript(statefulSet)).To(
+				ContainSubstring("default-time-zone=SYSTEM"))
+		})
+	})
+
+	Describe("statefulSetForCluster with pod labels", func() {
+		It("merges them onto the pod template but not the selector", func() {
+			cluster.Spec.PodLabels = map[string]string{"team": "payments"}
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statefulSet.Spec.Template.Labels).To(
+				HaveKeyWithValue("team", "payments"))
+			Expect(statefulSet.Spec.Template.Labels).To(
+				HaveKeyWithValue("app", cluster.Name))
+			Expect(statefulSet.Spec.Selector.MatchLabels).NotTo(HaveKey("team"))
+		})
+	})
+
+	Describe("statefulSetForCluster with custom configuration", func() {
+		customConfigVolume := func(statefulSet *appsv1.StatefulSet) *corev1.Volume {
+			for i, volume := range statefulSet.Spec.Template.Spec.Volumes {
+				if volume.Name == "custom-config" {
+					return &statefulSet.Spec.Template.Spec.Volumes[i]
+				}
+			}
+			return nil
+		}
+
+		It("mounts the referenced config map", func() {
+			cluster.Spec.ConfigMapName = "tuned-mysql"
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			volume := customConfigVolume(statefulSet)
+			Expect(volume).NotTo(BeNil())
+			Expect(volume.ConfigMap.Name).To(Equal("tuned-mysql"))
+		})
+
+		It("mounts the materialized inline config", func() {
+			cluster.Spec.Config = map[string]string{
+				"tuning.cnf": "[mysqld]\ninnodb_buffer_pool_size=2G\n",
+			}
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			volume := customConfigVolume(statefulSet)
+			Expect(volume).NotTo(BeNil())
+			Expect(volume.ConfigMap.Name).To(Equal(ConfigConfigMapName(cluster.ResourceName())))
+		})
+
+		It("omits the volume without custom configuration", func() {
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customConfigVolume(statefulSet)).To(BeNil())
+		})
+	})
+
+	Describe("statefulSetForCluster with extra mounts", func() {
+		It("mounts the sources into the mysql container", func() {
+			cluster.Spec.ExtraConfigMapMounts = []crv1.MySQLClusterExtraMount{
+				{Name: "ca-bundle", MountPath: "/etc/mysql/ca"},
+			}
+			cluster.Spec.ExtraSecretMounts = []crv1.MySQLClusterExtraMount{
+				{Name: "client-certs", MountPath: "/etc/mysql/certs"},
+			}
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			volumeNames := make([]string, 0, len(statefulSet.Spec.Template.Spec.Volumes))
+			for _, volume := range statefulSet.Spec.Template.Spec.Volumes {
+				volumeNames = append(volumeNames, volume.Name)
+			}
+			Expect(volumeNames).To(ContainElement("extra-config-ca-bundle"))
+			Expect(volumeNames).To(ContainElement("extra-secret-client-certs"))
+
+			for _, container := range statefulSet.Spec.Template.Spec.Containers {
+				if container.Name != "mysql" {
+					continue
+				}
+				Expect(container.VolumeMounts).To(ContainElement(
+					corev1.VolumeMount{Name: "extra-config-ca-bundle", MountPath: "/etc/mysql/ca"}))
+				Expect(container.VolumeMounts).To(ContainElement(
+					corev1.VolumeMount{Name: "extra-secret-client-certs", MountPath: "/etc/mysql/certs"}))
+			}
+		})
+	})
+
+	Describe("statefulSetForCluster with the slow query log enabled", func() {
+		It("injects the tail sidecar", func() {
+			cluster.Spec.SlowQueryLog = &crv1.MySQLClusterSlowQueryLog{ThresholdSeconds: 5}
+			statefulSet, err := statefulSetForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			names := make([]string, 0, len(statefulSet.Spec.Template.Spec.Containers))
+			for _, container := range statefulSet.Spec.Template.Spec.Containers {
+				names = append(names, container.Name)
+			}
+			Expect(names).To(ContainElement("slow-log"))
+		})
+	})
+
 	Describe("serviceForCluster should generate a service from the template", func() {
 		var service *corev1.Service
 
@@ -82,4 +312,76 @@ var _ = Describe("Operator", func() {
 			Expect(readService.OwnerReferences[0].Name).To(Equal("my-cluster"))
 		})
 	})
+
+	Describe("read service selector", func() {
+		readServiceSelector := func() map[string]string {
+			readService, err := serviceForCluster(cluster, serviceReadTemplate)
+			Expect(err).NotTo(HaveOccurred())
+			return readService.Spec.Selector
+		}
+
+		It("targets the replicas only when replicas are present", func() {
+			cluster.Spec.Replicas = 2
+			Expect(readServiceSelector()).To(HaveKeyWithValue(RoleLabel, RoleReplica))
+		})
+
+		It("includes the primary when requested", func() {
+			cluster.Spec.Replicas = 2
+			cluster.Spec.ReadServiceIncludesPrimary = true
+			Expect(readServiceSelector()).NotTo(HaveKey(RoleLabel))
+		})
+
+		It("falls back to the primary without replicas", func() {
+			cluster.Spec.Replicas = 1
+			Expect(readServiceSelector()).NotTo(HaveKey(RoleLabel))
+		})
+	})
+
+	Describe("load balancer IP", func() {
+		BeforeEach(func() {
+			cluster.Spec.LoadBalancerIP = "192.0.2.10"
+		})
+
+		It("turns the write service into a pinned LoadBalancer", func() {
+			service, err := serviceForCluster(cluster, serviceTemplate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(service.Spec.LoadBalancerIP).To(Equal("192.0.2.10"))
+			Expect(service.Spec.ClusterIP).To(BeEmpty())
+		})
+
+		It("leaves the read service untouched", func() {
+			readService, err := serviceForCluster(cluster, serviceReadTemplate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readService.Spec.Type).To(BeEmpty())
+			Expect(readService.Spec.LoadBalancerIP).To(BeEmpty())
+		})
+	})
+
+	Describe("service annotations", func() {
+		BeforeEach(func() {
+			cluster.Spec.WriteServiceAnnotations = map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+			}
+			cluster.Spec.ReadServiceAnnotations = map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+			}
+		})
+
+		It("sets the write service annotations on the write service only", func() {
+			service, err := serviceForCluster(cluster, serviceTemplate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(service.Annotations).To(HaveKeyWithValue(
+				"service.beta.kubernetes.io/aws-load-balancer-internal", "true"))
+			Expect(service.Annotations).NotTo(HaveKey(
+				"service.beta.kubernetes.io/aws-load-balancer-type"))
+		})
+
+		It("sets the read service annotations on the read service", func() {
+			readService, err := serviceForCluster(cluster, serviceReadTemplate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readService.Annotations).To(HaveKeyWithValue(
+				"service.beta.kubernetes.io/aws-load-balancer-type", "nlb"))
+		})
+	})
 })
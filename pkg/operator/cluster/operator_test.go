@@ -21,6 +21,71 @@ import (
 	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
 )
 
+var _ = Describe("Cluster Operator with defaults skipped", func() {
+	logrus.SetOutput(ioutil.Discard)
+
+	var (
+		operator      Operator
+		cluster       *crv1.MySQLCluster
+		kubeClientset *fake.Clientset
+		clientset     *versioned.Clientset
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory,
+			factory.WithTraits("ChangeDefaults"),
+		).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Annotations = map[string]string{
+			crv1.SkipDefaultsAnnotation: "true",
+		}
+
+		clientset = versioned.NewSimpleClientset()
+		kubeClientset = fake.NewSimpleClientset()
+
+		_, err = clientset.CrV1().MySQLClusters(cluster.Namespace).Create(cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		operator = NewClusterOperator(clientset, kubeClientset)
+	})
+
+	When("the spec is fully specified", func() {
+		It("uses the spec verbatim", func() {
+			port := cluster.Spec.Port
+			err := operator.AddCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cluster.Spec.Port).To(Equal(port))
+		})
+	})
+
+	When("a required field is missing", func() {
+		BeforeEach(func() {
+			cluster.Spec.Image = ""
+		})
+
+		It("fails validation instead of injecting a default", func() {
+			err := operator.AddCluster(cluster)
+			Expect(err).To(HaveOccurred())
+			Expect(cluster.Spec.Image).To(BeEmpty())
+		})
+	})
+
+	When("an init script is combined with a backup restore", func() {
+		BeforeEach(func() {
+			cluster.Spec.InitScript = &crv1.MySQLClusterInitScript{
+				Inline: "CREATE DATABASE app;",
+			}
+			cluster.Spec.FromBackup = "some-backup"
+		})
+
+		It("fails validation", func() {
+			err := operator.AddCluster(cluster)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
 var _ = Describe("Cluster Operator", func() {
 	logrus.SetOutput(ioutil.Discard)
 
@@ -103,6 +168,19 @@ var _ = Describe("Cluster Operator", func() {
 			Expect(readSvc.Spec.Selector["app"]).To(Equal(name))
 		})
 
+		It("publishes creation events", func() {
+			events, err := kubeClientset.CoreV1().
+				Events(metav1.NamespaceDefault).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			reasons := []string{}
+			for _, event := range events.Items {
+				reasons = append(reasons, event.Reason)
+			}
+			Expect(reasons).To(ContainElement("ServiceCreated"))
+			Expect(reasons).To(ContainElement("StatefulSetCreated"))
+		})
+
 		It("creates the appropriate StatefulSet", func() {
 			name := cluster.Name
 			sets, err := statefulSets.List(metav1.ListOptions{})
@@ -124,6 +202,197 @@ var _ = Describe("Cluster Operator", func() {
 		})
 	})
 
+	When("a cluster is deleted", func() {
+		JustBeforeEach(func() {
+			err := operator.DeleteCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes the Services", func() {
+			svcs, err := services.List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(svcs.Items).To(BeEmpty())
+		})
+
+		It("removes the StatefulSet", func() {
+			sets, err := statefulSets.List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sets.Items).To(BeEmpty())
+		})
+
+		It("tolerates the resources being gone already", func() {
+			Expect(operator.DeleteCluster(cluster)).To(Succeed())
+		})
+	})
+
+	When("a cluster is added again over drifted resources", func() {
+		JustBeforeEach(func() {
+			svc, err := services.Get(ServiceName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			svc.Spec.Ports[0].Port = 13306
+			_, err = services.Update(svc)
+			Expect(err).NotTo(HaveOccurred())
+
+			sts, err := statefulSets.Get(StatefulSetName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			sts.Spec.Template.Spec.Containers[0].Image = "mysql:drifted"
+			_, err = statefulSets.Update(sts)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = operator.AddCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("converges the Service back to the spec", func() {
+			svc, err := services.Get(ServiceName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(svc.Spec.Ports[0].Port).To(Equal(cluster.Spec.Port))
+		})
+
+		It("converges the StatefulSet back to the spec", func() {
+			sts, err := statefulSets.Get(StatefulSetName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sts.Spec.Template.Spec.Containers[0].Image).To(Equal(cluster.Spec.Image))
+		})
+	})
+
+	When("a cluster with data PVCs is finalized", func() {
+		pvcs := func() corev1.PersistentVolumeClaimInterface {
+			return kubeClientset.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault)
+		}
+
+		dataPVC := func(ordinal int) *apicorev1.PersistentVolumeClaim {
+			pvc := new(apicorev1.PersistentVolumeClaim)
+			pvc.Name = DataPVCName(cluster.Name, ordinal)
+			pvc.Namespace = metav1.NamespaceDefault
+			pvc.Labels = map[string]string{"app": cluster.Name}
+			return pvc
+		}
+
+		JustBeforeEach(func() {
+			// A claim beyond the current replica count, kept from an earlier
+			// scale-down.
+			stale := dataPVC(int(cluster.Spec.Replicas))
+			for _, pvc := range []*apicorev1.PersistentVolumeClaim{dataPVC(0), stale} {
+				_, err := pvcs().Create(pvc)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err := operator.FinalizeCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes every claim matching the cluster selector", func() {
+			claims, err := pvcs().List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.Items).To(BeEmpty())
+		})
+
+		It("tolerates the claims being gone on a retry", func() {
+			cluster.Finalizers = []string{ClusterFinalizer}
+			Expect(operator.FinalizeCluster(cluster)).To(Succeed())
+		})
+	})
+
+	When("a cluster with a foreign finalizer is finalized", func() {
+		const foreignFinalizer = "example.com/other-controller"
+
+		JustBeforeEach(func() {
+			cluster.Finalizers = []string{foreignFinalizer, ClusterFinalizer}
+			_, err := clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = operator.FinalizeCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes only its own finalizer", func() {
+			updated, err := clientset.CrV1().MySQLClusters(cluster.Namespace).
+				Get(cluster.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Finalizers).To(Equal([]string{foreignFinalizer}))
+		})
+	})
+
+	When("a cluster with metrics enabled is added", func() {
+		BeforeEach(func() {
+			cluster.Spec.Metrics = &crv1.MySQLClusterMetrics{
+				ScrapeInterval: "15s",
+				Labels:         map[string]string{"team": "db"},
+			}
+		})
+
+		It("creates a metrics service with scrape annotations", func() {
+			svc, err := services.Get(MetricsServiceName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(svc.Annotations["prometheus.io/scrape"]).To(Equal("true"))
+			Expect(svc.Annotations["prometheus.io/interval"]).To(Equal("15s"))
+			Expect(svc.Labels["team"]).To(Equal("db"))
+		})
+
+		It("adds the exporter sidecar to the stateful set", func() {
+			sts, err := statefulSets.Get(StatefulSetName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var found bool
+			for _, container := range sts.Spec.Template.Spec.Containers {
+				if container.Name == "metrics" {
+					found = true
+				}
+			}
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	When("a cluster with an inline init script is added", func() {
+		BeforeEach(func() {
+			cluster.Spec.InitScript = &crv1.MySQLClusterInitScript{
+				Inline: "CREATE DATABASE app;",
+			}
+		})
+
+		It("creates a config map holding the script", func() {
+			configMap, err := kubeClientset.CoreV1().ConfigMaps(metav1.NamespaceDefault).
+				Get(InitScriptConfigMapName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMap.Data["init.sql"]).To(Equal("CREATE DATABASE app;"))
+		})
+
+		It("mounts the script into the entrypoint init directory", func() {
+			sts, err := statefulSets.Get(StatefulSetName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var mountPath string
+			for _, mount := range sts.Spec.Template.Spec.Containers[0].VolumeMounts {
+				if mount.Name == "init-script" {
+					mountPath = mount.MountPath
+				}
+			}
+			Expect(mountPath).To(Equal("/docker-entrypoint-initdb.d"))
+		})
+	})
+
+	When("the stateful set service name went stale", func() {
+		JustBeforeEach(func() {
+			sts, err := statefulSets.Get(StatefulSetName(cluster.Name), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			sts.Spec.ServiceName = "some-other-service"
+			_, err = statefulSets.Update(sts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the cluster as Degraded", func() {
+			err := operator.UpdateCluster(cluster)
+			Expect(err).To(HaveOccurred())
+
+			updated, err := clientset.CrV1().MySQLClusters(cluster.Namespace).
+				Get(cluster.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Status.State).To(Equal("Degraded"))
+			Expect(updated.Status.Message).To(ContainSubstring("serviceName is immutable"))
+		})
+	})
+
 	When("a cluster is updated", func() {
 		var updatedCluster *crv1.MySQLCluster
 
@@ -0,0 +1,22 @@
+package cluster
+
+import "github.com/grtl/mysql-operator/pkg/metrics"
+
+// phaseForState maps the free-form status state onto the stable numeric
+// phase exported as the mysql_cluster_phase gauge. Unknown states count as
+// Creating - the cluster has not reached a steady state yet.
+func phaseForState(state string) int {
+	switch state {
+	case "Ready", "Successful update", "Migration complete, read traffic enabled":
+		return metrics.PhaseReady
+	case "MigrationPending":
+		return metrics.PhaseUpdating
+	case "Degraded":
+		return metrics.PhaseDegraded
+	case "BootstrapFailed", "MigrationFailed", "Failed adoption",
+		"Incompatible restore", "VersionMismatch":
+		return metrics.PhaseFailed
+	default:
+		return metrics.PhaseCreating
+	}
+}
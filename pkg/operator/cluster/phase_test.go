@@ -0,0 +1,29 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/grtl/mysql-operator/pkg/metrics"
+)
+
+var _ = Describe("Phase", func() {
+	It("maps the steady states to Ready", func() {
+		Expect(phaseForState("Ready")).To(Equal(metrics.PhaseReady))
+		Expect(phaseForState("Successful update")).To(Equal(metrics.PhaseReady))
+	})
+
+	It("maps a pending migration to Updating", func() {
+		Expect(phaseForState("MigrationPending")).To(Equal(metrics.PhaseUpdating))
+	})
+
+	It("maps failures to Failed", func() {
+		Expect(phaseForState("BootstrapFailed")).To(Equal(metrics.PhaseFailed))
+		Expect(phaseForState("VersionMismatch")).To(Equal(metrics.PhaseFailed))
+	})
+
+	It("counts unknown states as Creating", func() {
+		Expect(phaseForState("")).To(Equal(metrics.PhaseCreating))
+		Expect(phaseForState("Dry run")).To(Equal(metrics.PhaseCreating))
+	})
+})
@@ -0,0 +1,122 @@
+package cluster
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/util"
+)
+
+const (
+	proxyDeploymentTemplate = "artifacts/cluster-proxy-deployment.yaml"
+	proxyServiceTemplate    = "artifacts/cluster-service-proxy.yaml"
+	proxyConfigMapTemplate  = "artifacts/cluster-configmap-proxysql.yaml"
+)
+
+// ensureProxy deploys the ProxySQL connection pooler in front of the
+// cluster: its configuration, the proxy deployment and the service clients
+// connect to. Reads and writes are split at the proxy layer - SELECTs go to
+// the read service, everything else to the write service.
+func (c *clusterOperator) ensureProxy(cluster *crv1.MySQLCluster) error {
+	backends, err := c.proxyBackends(cluster)
+	if err != nil {
+		return err
+	}
+
+	err = c.ensureProxyConfigMap(cluster, backends)
+	if err != nil {
+		return err
+	}
+
+	err = c.ensureProxyDeployment(cluster)
+	if err != nil {
+		return err
+	}
+
+	return c.createProxyService(cluster)
+}
+
+// ensureProxyConfigMap creates the ProxySQL configuration, or overwrites an
+// existing one - the operator owns the proxy configuration in full.
+func (c *clusterOperator) ensureProxyConfigMap(cluster *crv1.MySQLCluster, backends []string) error {
+	configMap, err := proxyConfigMapForCluster(cluster, backends)
+	if err != nil {
+		return err
+	}
+
+	configMapInterface := c.kubeClientset.CoreV1().ConfigMaps(cluster.Namespace)
+	_, err = configMapInterface.Create(configMap)
+	if apierrors.IsAlreadyExists(err) {
+		_, err = configMapInterface.Update(configMap)
+	}
+	return err
+}
+
+// ensureProxyDeployment creates the proxy deployment, or resizes an existing
+// one toward the spec. The operator owns the replica count and the pod
+// template.
+func (c *clusterOperator) ensureProxyDeployment(cluster *crv1.MySQLCluster) error {
+	deployment, err := proxyDeploymentForCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	deploymentInterface := c.kubeClientset.AppsV1().Deployments(cluster.Namespace)
+	_, err = deploymentInterface.Create(deployment)
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	live, err := deploymentInterface.Get(deployment.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	live.Spec.Replicas = deployment.Spec.Replicas
+	live.Spec.Template = deployment.Spec.Template
+	_, err = deploymentInterface.Update(live)
+	return err
+}
+
+func (c *clusterOperator) createProxyService(cluster *crv1.MySQLCluster) error {
+	service, err := proxyServiceForCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.kubeClientset.CoreV1().Services(cluster.Namespace).Create(service)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	} else if apierrors.IsAlreadyExists(err) {
+		logging.LogCluster(cluster).Warn("Proxy service for cluster already exists")
+	}
+
+	return nil
+}
+
+func proxyConfigMapForCluster(cluster *crv1.MySQLCluster, backends []string) (*corev1.ConfigMap, error) {
+	configMap := new(corev1.ConfigMap)
+	err := util.ObjectFromTemplate(struct {
+		*crv1.MySQLCluster
+		Backends []string
+	}{
+		cluster,
+		backends,
+	}, configMap, proxyConfigMapTemplate, FuncMap)
+	return configMap, err
+}
+
+func proxyDeploymentForCluster(cluster *crv1.MySQLCluster) (*appsv1.Deployment, error) {
+	deployment := new(appsv1.Deployment)
+	err := util.ObjectFromTemplate(cluster, deployment, proxyDeploymentTemplate, FuncMap)
+	return deployment, err
+}
+
+func proxyServiceForCluster(cluster *crv1.MySQLCluster) (*corev1.Service, error) {
+	service := new(corev1.Service)
+	err := util.ObjectFromTemplate(cluster, service, proxyServiceTemplate, FuncMap)
+	return service, err
+}
@@ -0,0 +1,98 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Proxy", func() {
+	var cluster *crv1.MySQLCluster
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.Spec.Proxy = &crv1.MySQLClusterProxy{}
+		cluster.WithDefaults()
+	})
+
+	Describe("proxyDeploymentForCluster", func() {
+		It("renders the sized ProxySQL deployment owned by the cluster", func() {
+			cluster.Spec.Proxy.Replicas = 2
+
+			deployment, err := proxyDeploymentForCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.Name).To(Equal(ProxyName(cluster.ResourceName())))
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(
+				Equal(crv1.DefaultProxyImage))
+			Expect(deployment.OwnerReferences).To(HaveLen(1))
+			Expect(deployment.OwnerReferences[0].Name).To(Equal(cluster.Name))
+		})
+	})
+
+	Describe("proxyConfigMapForCluster", func() {
+		It("routes writes and reads through the cluster services", func() {
+			configMap, err := proxyConfigMapForCluster(cluster, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			config := configMap.Data["proxysql.cnf.tpl"]
+			Expect(config).To(ContainSubstring(ServiceName(cluster.ResourceName())))
+			Expect(config).To(ContainSubstring(ReadServiceName(cluster.ResourceName())))
+			Expect(config).To(ContainSubstring(`match_digest="^SELECT"`))
+		})
+	})
+
+	Describe("proxyServiceForCluster", func() {
+		It("selects only the proxy pods", func() {
+			service, err := proxyServiceForCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(service.Spec.Selector["app"]).To(Equal(ProxyName(cluster.Name)))
+		})
+	})
+
+	Describe("ensureProxy", func() {
+		It("creates the config map, deployment and service, then resizes", func() {
+			operator := &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(cluster),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+
+			Expect(operator.ensureProxy(cluster)).To(Succeed())
+
+			deploymentInterface := operator.kubeClientset.AppsV1().
+				Deployments(metav1.NamespaceDefault)
+			deployment, err := deploymentInterface.Get(
+				ProxyName(cluster.ResourceName()), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*deployment.Spec.Replicas).To(Equal(crv1.DefaultProxyReplicas))
+
+			_, err = operator.kubeClientset.CoreV1().
+				ConfigMaps(metav1.NamespaceDefault).
+				Get(ProxyConfigMapName(cluster.ResourceName()), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = operator.kubeClientset.CoreV1().
+				Services(metav1.NamespaceDefault).
+				Get(ProxyName(cluster.ResourceName()), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			cluster.Spec.Proxy.Replicas = 3
+			Expect(operator.ensureProxy(cluster)).To(Succeed())
+			deployment, err = deploymentInterface.Get(
+				ProxyName(cluster.ResourceName()), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	"strconv"
+	"strings"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// pvcRetentionMinorVersion is the first Kubernetes minor version honoring
+// the stateful set persistentVolumeClaimRetentionPolicy field.
+const pvcRetentionMinorVersion = 27
+
+// warnPVCRetentionUnsupported warns when the cluster configures a PVC
+// retention policy the API server is too old to honor. The field is then
+// silently dropped by the server.
+func (c *clusterOperator) warnPVCRetentionUnsupported(cluster *crv1.MySQLCluster) {
+	if cluster.Spec.PVCRetentionPolicy == nil {
+		return
+	}
+
+	version, err := c.kubeClientset.Discovery().ServerVersion()
+	if err != nil {
+		return
+	}
+
+	minor, err := strconv.Atoi(strings.TrimSuffix(version.Minor, "+"))
+	if err != nil {
+		return
+	}
+
+	if version.Major == "1" && minor < pvcRetentionMinorVersion {
+		logging.LogCluster(cluster).WithField(
+			"serverVersion", version.GitVersion).Warn(
+			"The API server does not support persistentVolumeClaimRetentionPolicy, it will be ignored")
+	}
+}
@@ -0,0 +1,57 @@
+package cluster
+
+import (
+	"fmt"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// readOnlyCommand returns the SQL statements enforcing the desired write
+// mode on the primary.
+func readOnlyCommand(readOnly bool) string {
+	if readOnly {
+		return "SET GLOBAL super_read_only = ON; SET GLOBAL read_only = ON;"
+	}
+	return "SET GLOBAL read_only = OFF; SET GLOBAL super_read_only = OFF;"
+}
+
+// applyReadOnly toggles the primary between read-only and writable according
+// to the spec and reflects the result in the cluster status.
+func (c *clusterOperator) applyReadOnly(cluster *crv1.MySQLCluster) error {
+	if c.restConfig == nil {
+		// Without a rest config we cannot exec into pods.
+		return nil
+	}
+
+	if cluster.Spec.ReadOnly == cluster.Status.ReadOnly {
+		return nil
+	}
+
+	if cluster.Spec.ReadOnly {
+		logging.LogCluster(cluster).Info("Forcing the primary read-only.")
+	} else {
+		logging.LogCluster(cluster).Info("Re-enabling writes on the primary.")
+	}
+
+	err := c.execOnPrimary(cluster, readOnlyCommand(cluster.Spec.ReadOnly))
+	if err != nil {
+		return err
+	}
+
+	cluster.Status.ReadOnly = cluster.Spec.ReadOnly
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// execOnPrimary runs SQL statements on the primary pod.
+func (c *clusterOperator) execOnPrimary(cluster *crv1.MySQLCluster, sql string) error {
+	return c.execOnPod(cluster, 0, sql)
+}
+
+// execOnPod runs SQL statements on the pod with the given ordinal.
+func (c *clusterOperator) execOnPod(cluster *crv1.MySQLCluster, ordinal int, sql string) error {
+	command := fmt.Sprintf(`mysql -h 127.0.0.1 -p"${MYSQL_ROOT_PASSWORD}" -e '%s'`, sql)
+	_, err := c.execInPod(cluster, ordinal, command)
+	return err
+}
@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Read Only", func() {
+	Describe("readOnlyCommand", func() {
+		It("should enable super_read_only when forcing read-only", func() {
+			Expect(readOnlyCommand(true)).To(
+				Equal("SET GLOBAL super_read_only = ON; SET GLOBAL read_only = ON;"))
+		})
+
+		It("should re-enable writes when toggled off", func() {
+			Expect(readOnlyCommand(false)).To(
+				Equal("SET GLOBAL read_only = OFF; SET GLOBAL super_read_only = OFF;"))
+		})
+	})
+
+	Describe("applyReadOnly", func() {
+		var (
+			cluster  *crv1.MySQLCluster
+			operator *clusterOperator
+		)
+
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+		})
+
+		It("is skipped when no rest config is configured", func() {
+			cluster.Spec.ReadOnly = true
+			Expect(operator.applyReadOnly(cluster)).To(Succeed())
+		})
+
+		It("does nothing when the status already matches the spec", func() {
+			cluster.Spec.ReadOnly = true
+			cluster.Status.ReadOnly = true
+			Expect(operator.applyReadOnly(cluster)).To(Succeed())
+			Expect(cluster.Status.ReadOnly).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package cluster
+
+import (
+	"sort"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// reconcileReadWriteSplit keeps the read routing consistent across replica
+// scale events. The read service selector is re-rendered by updateServices;
+// this step additionally rewrites the ProxySQL backend list to the current
+// set of ready replicas, so the proxy neither reads from removed replicas
+// nor misses added ones.
+func (c *clusterOperator) reconcileReadWriteSplit(cluster *crv1.MySQLCluster) error {
+	if cluster.Spec.Proxy == nil {
+		return nil
+	}
+
+	backends, err := c.proxyBackends(cluster)
+	if err != nil {
+		return err
+	}
+
+	return c.ensureProxyConfigMap(cluster, backends)
+}
+
+// proxyBackends returns the addresses of the ready replicas the proxy reads
+// from, ordered by ordinal. Empty when no replica is ready - the proxy
+// config then falls back to the read service.
+func (c *clusterOperator) proxyBackends(cluster *crv1.MySQLCluster) ([]string, error) {
+	pods, err := c.kubeClientset.CoreV1().Pods(cluster.Namespace).
+		List(listOptionsForCluster(cluster))
+	if err != nil {
+		return nil, err
+	}
+
+	primary := primaryOrdinal(pods.Items)
+	ordinals := []int{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil || ordinal == primary {
+			continue
+		}
+		if !IsPodReady(pod) {
+			continue
+		}
+		ordinals = append(ordinals, ordinal)
+	}
+	sort.Ints(ordinals)
+
+	backends := make([]string, 0, len(ordinals))
+	for _, ordinal := range ordinals {
+		backends = append(backends, PodAddress(cluster.ResourceName(), ordinal))
+	}
+	return backends, nil
+}
@@ -0,0 +1,86 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("ReadWriteSplit", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	proxyConfig := func() string {
+		configMap, err := operator.kubeClientset.CoreV1().
+			ConfigMaps(metav1.NamespaceDefault).
+			Get(ProxyConfigMapName(cluster.ResourceName()), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return configMap.Data["proxysql.cnf.tpl"]
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.Spec.Proxy = new(crv1.MySQLClusterProxy)
+		cluster.WithDefaults()
+
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(
+				readyPodWithRole(cluster.Name, 0, RolePrimary),
+				readyPodWithRole(cluster.Name, 1, RoleReplica),
+			),
+		}
+	})
+
+	It("is a no-op without a proxy", func() {
+		cluster.Spec.Proxy = nil
+		Expect(operator.reconcileReadWriteSplit(cluster)).To(Succeed())
+	})
+
+	It("adds a scaled-up replica to the proxy backends", func() {
+		Expect(operator.ensureProxy(cluster)).To(Succeed())
+		Expect(proxyConfig()).NotTo(ContainSubstring(PodAddress(cluster.ResourceName(), 2)))
+
+		_, err := operator.kubeClientset.CoreV1().Pods(metav1.NamespaceDefault).
+			Create(readyPodWithRole(cluster.Name, 2, RoleReplica))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(operator.reconcileReadWriteSplit(cluster)).To(Succeed())
+		config := proxyConfig()
+		Expect(config).To(ContainSubstring(PodAddress(cluster.ResourceName(), 1)))
+		Expect(config).To(ContainSubstring(PodAddress(cluster.ResourceName(), 2)))
+	})
+
+	It("drops a scaled-down replica from the proxy backends", func() {
+		Expect(operator.ensureProxy(cluster)).To(Succeed())
+
+		err := operator.kubeClientset.CoreV1().Pods(metav1.NamespaceDefault).
+			Delete(PodName(cluster.Name, 1), new(metav1.DeleteOptions))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(operator.reconcileReadWriteSplit(cluster)).To(Succeed())
+		config := proxyConfig()
+		Expect(config).NotTo(ContainSubstring(PodAddress(cluster.ResourceName(), 1)))
+		// Without a ready replica reads fall back to the read service.
+		Expect(config).To(ContainSubstring(ReadServiceName(cluster.ResourceName())))
+	})
+
+	It("never reads from the primary", func() {
+		Expect(operator.reconcileReadWriteSplit(cluster)).To(Succeed())
+		Expect(proxyConfig()).NotTo(ContainSubstring(
+			PodAddress(cluster.ResourceName(), 0)))
+	})
+})
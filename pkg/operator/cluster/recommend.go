@@ -0,0 +1,139 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// recommendationHeadroomPercent is the safety margin added on top of the
+// observed peak usage, so the recommendation leaves room for spikes.
+const recommendationHeadroomPercent = 25
+
+// RecommendResources samples every member's current CPU and memory usage
+// from the metrics API and records an advisory per-pod resource
+// recommendation in the cluster status. The recommendation only ever grows,
+// so it converges on the peak usage observed over time; applying it is left
+// to the user.
+func (c *clusterOperator) RecommendResources(cluster *crv1.MySQLCluster) error {
+	var peakCPU, peakMemory resource.Quantity
+	sampled := false
+
+	for ordinal := 0; ordinal < int(cluster.Spec.Replicas); ordinal++ {
+		raw, err := c.podMetrics(cluster, ordinal)
+		if err != nil {
+			// A missing pod or an unavailable metrics API must not fail the
+			// whole pass.
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to read the pod usage metrics")
+			continue
+		}
+		cpu, memory, err := parsePodUsage(raw)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to parse the pod usage metrics")
+			continue
+		}
+		if cpu.Cmp(peakCPU) > 0 {
+			peakCPU = cpu
+		}
+		if memory.Cmp(peakMemory) > 0 {
+			peakMemory = memory
+		}
+		sampled = true
+	}
+	if !sampled {
+		// Nothing observed - keep the previous recommendation as it is.
+		return nil
+	}
+
+	recommendation := crv1.MySQLClusterResourceRecommendation{
+		CPU:        withHeadroomMilli(peakCPU),
+		Memory:     withHeadroom(peakMemory),
+		ObservedAt: metav1.Now(),
+	}
+	merged := mergeRecommendation(cluster.Status.ResourceRecommendation, recommendation)
+	cluster.Status.ResourceRecommendation = &merged
+
+	_, err := c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// podMetrics reads the raw metrics API object of the pod with the given
+// ordinal. The typed metrics client is not vendored, so the object is
+// fetched through the discovery REST client and decoded by hand.
+func (c *clusterOperator) podMetrics(cluster *crv1.MySQLCluster, ordinal int) ([]byte, error) {
+	return c.kubeClientset.Discovery().RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", cluster.Namespace,
+			"pods", PodName(cluster.ResourceName(), ordinal)).
+		DoRaw()
+}
+
+// parsePodUsage sums the container CPU and memory usage out of a raw
+// metrics API pod object.
+func parsePodUsage(raw []byte) (cpu, memory resource.Quantity, err error) {
+	var metrics struct {
+		Containers []struct {
+			Usage map[string]string `json:"usage"`
+		} `json:"containers"`
+	}
+	err = json.Unmarshal(raw, &metrics)
+	if err != nil {
+		return cpu, memory, fmt.Errorf("unexpected metrics API response: %v", err)
+	}
+
+	for _, container := range metrics.Containers {
+		if value, ok := container.Usage["cpu"]; ok {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return cpu, memory, fmt.Errorf("invalid cpu usage %q", value)
+			}
+			cpu.Add(quantity)
+		}
+		if value, ok := container.Usage["memory"]; ok {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return cpu, memory, fmt.Errorf("invalid memory usage %q", value)
+			}
+			memory.Add(quantity)
+		}
+	}
+	return cpu, memory, nil
+}
+
+// withHeadroom returns the quantity grown by the recommendation headroom.
+func withHeadroom(usage resource.Quantity) resource.Quantity {
+	value := usage.Value()
+	return *resource.NewQuantity(
+		value+value*recommendationHeadroomPercent/100, resource.BinarySI)
+}
+
+// withHeadroomMilli is withHeadroom at milli-unit granularity, so small CPU
+// usages do not round away.
+func withHeadroomMilli(usage resource.Quantity) resource.Quantity {
+	value := usage.MilliValue()
+	return *resource.NewMilliQuantity(
+		value+value*recommendationHeadroomPercent/100, resource.DecimalSI)
+}
+
+// mergeRecommendation folds a new recommendation into the previous one,
+// keeping the larger value per dimension, so the recommendation reflects the
+// peak usage observed over time.
+func mergeRecommendation(previous *crv1.MySQLClusterResourceRecommendation,
+	next crv1.MySQLClusterResourceRecommendation) crv1.MySQLClusterResourceRecommendation {
+	if previous == nil {
+		return next
+	}
+	if previous.CPU.Cmp(next.CPU) > 0 {
+		next.CPU = previous.CPU
+	}
+	if previous.Memory.Cmp(next.Memory) > 0 {
+		next.Memory = previous.Memory
+	}
+	return next
+}
@@ -0,0 +1,68 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Resource recommendations", func() {
+	Describe("parsePodUsage", func() {
+		It("sums the container usages", func() {
+			raw := []byte(`{"containers": [
+				{"name": "mysql", "usage": {"cpu": "250m", "memory": "1Gi"}},
+				{"name": "metrics", "usage": {"cpu": "10m", "memory": "32Mi"}}
+			]}`)
+
+			cpu, memory, err := parsePodUsage(raw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpu.MilliValue()).To(Equal(int64(260)))
+			Expect(memory.Value()).To(Equal(int64((1024 + 32) * 1024 * 1024)))
+		})
+
+		It("rejects an invalid quantity", func() {
+			raw := []byte(`{"containers": [{"usage": {"cpu": "lots"}}]}`)
+
+			_, _, err := parsePodUsage(raw)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("withHeadroom", func() {
+		It("adds the safety margin", func() {
+			memory := withHeadroom(resource.MustParse("4Gi"))
+			Expect(memory.Value()).To(Equal(int64(5 * 1024 * 1024 * 1024)))
+
+			cpu := withHeadroomMilli(resource.MustParse("200m"))
+			Expect(cpu.MilliValue()).To(Equal(int64(250)))
+		})
+	})
+
+	Describe("mergeRecommendation", func() {
+		It("keeps the larger value per dimension", func() {
+			previous := &crv1.MySQLClusterResourceRecommendation{
+				CPU:    resource.MustParse("500m"),
+				Memory: resource.MustParse("1Gi"),
+			}
+			next := crv1.MySQLClusterResourceRecommendation{
+				CPU:    resource.MustParse("250m"),
+				Memory: resource.MustParse("2Gi"),
+			}
+
+			merged := mergeRecommendation(previous, next)
+			Expect(merged.CPU.MilliValue()).To(Equal(int64(500)))
+			Expect(merged.Memory.Value()).To(Equal(int64(2 * 1024 * 1024 * 1024)))
+		})
+
+		It("uses the new recommendation when there is no previous one", func() {
+			next := crv1.MySQLClusterResourceRecommendation{
+				CPU:    resource.MustParse("250m"),
+				Memory: resource.MustParse("2Gi"),
+			}
+			Expect(mergeRecommendation(nil, next)).To(Equal(next))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package cluster
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// recreateStatefulSetIfNeeded deletes and recreates the stateful set when an
+// immutable field has to change. The pods are orphaned during the deletion
+// and adopted again by the recreated stateful set via its label selector.
+// It returns the name of the changed field when a recreation happened.
+func (c *clusterOperator) recreateStatefulSetIfNeeded(cluster *crv1.MySQLCluster) (string, error) {
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	live, err := statefulSetInterface.Get(StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	desired, err := statefulSetForCluster(cluster, nil)
+	if err != nil {
+		return "", err
+	}
+
+	field := immutableFieldChanged(desired, live)
+	if field == "" {
+		return "", nil
+	}
+
+	logging.LogCluster(cluster).WithField(
+		"field", field).Warn("Recreating the stateful set for an immutable field change")
+
+	orphan := metav1.DeletePropagationOrphan
+	err = statefulSetInterface.Delete(live.Name, &metav1.DeleteOptions{
+		PropagationPolicy: &orphan,
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+
+	_, err = statefulSetInterface.Create(desired)
+	if err != nil {
+		return "", err
+	}
+
+	return field, nil
+}
+
+// changedImmutableField returns the name of an immutable stateful set field
+// the cluster spec tries to change, or "" when there is none or no stateful
+// set exists yet.
+func (c *clusterOperator) changedImmutableField(cluster *crv1.MySQLCluster) (string, error) {
+	live, err := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace).
+		Get(StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	desired, err := statefulSetForCluster(cluster, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return immutableFieldChanged(desired, live), nil
+}
+
+// immutableFieldChanged returns the name of an immutable stateful set field
+// which differs between the desired and live object, or "" when none does.
+func immutableFieldChanged(desired, live *appsv1.StatefulSet) string {
+	if desired.Spec.ServiceName != live.Spec.ServiceName {
+		return "serviceName"
+	}
+
+	if desired.Spec.PodManagementPolicy != "" &&
+		desired.Spec.PodManagementPolicy != live.Spec.PodManagementPolicy {
+		return "podManagementPolicy"
+	}
+
+	if volumeClaimTemplatesChanged(desired, live) {
+		return "volumeClaimTemplates"
+	}
+
+	return ""
+}
+
+func volumeClaimTemplatesChanged(desired, live *appsv1.StatefulSet) bool {
+	if len(desired.Spec.VolumeClaimTemplates) != len(live.Spec.VolumeClaimTemplates) {
+		return true
+	}
+
+	for i := range desired.Spec.VolumeClaimTemplates {
+		desiredClaim := &desired.Spec.VolumeClaimTemplates[i]
+		liveClaim := &live.Spec.VolumeClaimTemplates[i]
+		if desiredClaim.Name != liveClaim.Name {
+			return true
+		}
+
+		desiredStorage := desiredClaim.Spec.Resources.Requests["storage"]
+		liveStorage := liveClaim.Spec.Resources.Requests["storage"]
+		if desiredStorage.Cmp(liveStorage) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
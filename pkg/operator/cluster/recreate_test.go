@@ -0,0 +1,53 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func statefulSetWithService(serviceName, storage string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		Spec: appsv1.StatefulSetSpec{
+			ServiceName: serviceName,
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"storage": resource.MustParse(storage),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Recreate", func() {
+	Describe("immutableFieldChanged", func() {
+		It("detects a changed service name", func() {
+			desired := statefulSetWithService("my-cluster", "1Gi")
+			live := statefulSetWithService("old-service", "1Gi")
+			Expect(immutableFieldChanged(desired, live)).To(Equal("serviceName"))
+		})
+
+		It("detects changed volume claim templates", func() {
+			desired := statefulSetWithService("my-cluster", "2Gi")
+			live := statefulSetWithService("my-cluster", "1Gi")
+			Expect(immutableFieldChanged(desired, live)).To(Equal("volumeClaimTemplates"))
+		})
+
+		It("reports nothing when the immutable fields match", func() {
+			desired := statefulSetWithService("my-cluster", "1Gi")
+			live := statefulSetWithService("my-cluster", "1Gi")
+			Expect(immutableFieldChanged(desired, live)).To(BeEmpty())
+		})
+	})
+})
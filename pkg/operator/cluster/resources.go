@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// Default resource requests of the metrics exporter sidecar.
+const (
+	DefaultExporterCPURequest    = "100m"
+	DefaultExporterMemoryRequest = "100Mi"
+)
+
+// containerResources holds container resources in a template-friendly form.
+type containerResources struct {
+	Requests map[string]string
+	Limits   map[string]string
+}
+
+// metricsResources returns the exporter sidecar resources with the default
+// requests applied where none are configured.
+func metricsResources(metrics *crv1.MySQLClusterMetrics) containerResources {
+	resources := containerResources{
+		Requests: quantityStrings(metrics.Resources.Requests),
+		Limits:   quantityStrings(metrics.Resources.Limits),
+	}
+
+	if _, ok := resources.Requests[string(corev1.ResourceCPU)]; !ok {
+		resources.Requests[string(corev1.ResourceCPU)] = DefaultExporterCPURequest
+	}
+	if _, ok := resources.Requests[string(corev1.ResourceMemory)]; !ok {
+		resources.Requests[string(corev1.ResourceMemory)] = DefaultExporterMemoryRequest
+	}
+
+	return resources
+}
+
+func quantityStrings(list corev1.ResourceList) map[string]string {
+	strings := map[string]string{}
+	for name, quantity := range list {
+		strings[string(name)] = quantity.String()
+	}
+	return strings
+}
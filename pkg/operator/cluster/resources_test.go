@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+var _ = Describe("Resources", func() {
+	Describe("metricsResources", func() {
+		It("applies the default requests when none are configured", func() {
+			resources := metricsResources(&crv1.MySQLClusterMetrics{})
+			Expect(resources.Requests).To(HaveKeyWithValue("cpu", DefaultExporterCPURequest))
+			Expect(resources.Requests).To(HaveKeyWithValue("memory", DefaultExporterMemoryRequest))
+			Expect(resources.Limits).To(BeEmpty())
+		})
+
+		It("keeps the configured requests and limits", func() {
+			resources := metricsResources(&crv1.MySQLClusterMetrics{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				},
+			})
+			Expect(resources.Requests).To(HaveKeyWithValue("memory", "64Mi"))
+			Expect(resources.Requests).To(HaveKeyWithValue("cpu", DefaultExporterCPURequest))
+			Expect(resources.Limits).To(HaveKeyWithValue("memory", "256Mi"))
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package cluster
+
+import (
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// gcControllerRevisions deletes the oldest ControllerRevisions of the
+// cluster's stateful set, keeping the newest limit of them. The StatefulSet
+// controller prunes its own history via revisionHistoryLimit, but during
+// rapid config churn revisions can accumulate faster than its GC runs - this
+// pass is the belt and suspenders behind it.
+func (c *clusterOperator) gcControllerRevisions(cluster *crv1.MySQLCluster, limit int) error {
+	revisionInterface := c.kubeClientset.AppsV1().ControllerRevisions(cluster.Namespace)
+	revisions, err := revisionInterface.List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+	if len(revisions.Items) <= limit {
+		return nil
+	}
+
+	sort.Slice(revisions.Items, func(i, j int) bool {
+		return revisions.Items[i].Revision < revisions.Items[j].Revision
+	})
+
+	for i := range revisions.Items[:len(revisions.Items)-limit] {
+		err = revisionInterface.Delete(revisions.Items[i].Name, new(metav1.DeleteOptions))
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
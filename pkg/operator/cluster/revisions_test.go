@@ -0,0 +1,82 @@
+package cluster
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Revisions", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	revisionNamed := func(number int64) *appsv1.ControllerRevision {
+		revision := new(appsv1.ControllerRevision)
+		revision.Name = fmt.Sprintf("%s-%d", cluster.Name, number)
+		revision.Namespace = metav1.NamespaceDefault
+		revision.Labels = map[string]string{"app": cluster.Name}
+		revision.Revision = number
+		return revision
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+	})
+
+	It("deletes the stale revisions beyond the limit", func() {
+		operator = &clusterOperator{
+			clientset: versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(
+				revisionNamed(1),
+				revisionNamed(2),
+				revisionNamed(3),
+				revisionNamed(4),
+				revisionNamed(5),
+			),
+		}
+
+		Expect(operator.gcControllerRevisions(cluster, 2)).To(Succeed())
+
+		revisions, err := operator.kubeClientset.AppsV1().
+			ControllerRevisions(metav1.NamespaceDefault).List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		names := make([]string, 0, len(revisions.Items))
+		for _, revision := range revisions.Items {
+			names = append(names, revision.Name)
+		}
+		Expect(names).To(ConsistOf(
+			fmt.Sprintf("%s-4", cluster.Name),
+			fmt.Sprintf("%s-5", cluster.Name)))
+	})
+
+	It("keeps everything when under the limit", func() {
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(revisionNamed(1)),
+		}
+
+		Expect(operator.gcControllerRevisions(cluster, 2)).To(Succeed())
+
+		revisions, err := operator.kubeClientset.AppsV1().
+			ControllerRevisions(metav1.NamespaceDefault).List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revisions.Items).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// checkScaleDown refuses a scale-down which would delete the pod currently
+// serving as primary or leave the cluster without a single healthy member.
+// The primary has to be switched over to a surviving ordinal first.
+func (c *clusterOperator) checkScaleDown(cluster *crv1.MySQLCluster) error {
+	statefulSetInterface := c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace)
+	statefulSet, err := statefulSetInterface.Get(StatefulSetName(cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	newReplicas := cluster.Spec.Replicas
+	if newReplicas >= replicasOrDefault(statefulSet) {
+		return nil
+	}
+
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	pods, err := podInterface.List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+
+	primary := primaryOrdinal(pods.Items)
+	if int32(primary) >= newReplicas {
+		return fmt.Errorf(
+			"cannot scale cluster %q down to %d replicas: the current primary %q "+
+				"would be deleted, switch it over to a lower ordinal first",
+			cluster.Name, newReplicas, PodName(cluster.ResourceName(), primary))
+	}
+
+	if healthySurvivors(pods.Items, newReplicas) == 0 {
+		return fmt.Errorf(
+			"cannot scale cluster %q down to %d replicas: no healthy member would remain",
+			cluster.Name, newReplicas)
+	}
+
+	return nil
+}
+
+// healthySurvivors counts the ready pods which a scale-down to the given
+// replica count would keep.
+func healthySurvivors(pods []corev1.Pod, replicas int32) int {
+	count := 0
+	for i := range pods {
+		pod := &pods[i]
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil || int32(ordinal) >= replicas {
+			continue
+		}
+		if IsPodReady(pod) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+func readyPodWithRole(clusterName string, ordinal int, role string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PodName(clusterName, ordinal),
+			Namespace: metav1.NamespaceDefault,
+			Labels:    map[string]string{"app": clusterName},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+	if role != "" {
+		pod.Annotations = map[string]string{RoleAnnotation: role}
+	}
+	return pod
+}
+
+var _ = Describe("Scale Down", func() {
+	Describe("healthySurvivors", func() {
+		It("counts only ready pods below the new replica count", func() {
+			pods := []corev1.Pod{
+				*readyPodWithRole("my-cluster", 0, ""),
+				*readyPodWithRole("my-cluster", 1, ""),
+				*readyPodWithRole("my-cluster", 2, ""),
+			}
+			pods[1].Status.Conditions = nil
+			Expect(healthySurvivors(pods, 2)).To(Equal(1))
+		})
+	})
+
+	Describe("checkScaleDown", func() {
+		var (
+			cluster  *crv1.MySQLCluster
+			operator *clusterOperator
+		)
+
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			cluster.WithDefaults()
+
+			liveReplicas := int32(3)
+			operator = &clusterOperator{
+				clientset: versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(
+					&appsv1.StatefulSet{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      StatefulSetName(cluster.Name),
+							Namespace: cluster.Namespace,
+						},
+						Spec: appsv1.StatefulSetSpec{
+							Replicas: &liveReplicas,
+						},
+					},
+					readyPodWithRole(cluster.Name, 0, RoleReplica),
+					readyPodWithRole(cluster.Name, 1, RoleReplica),
+					readyPodWithRole(cluster.Name, 2, RolePrimary),
+				),
+			}
+		})
+
+		It("allows scale-ups", func() {
+			cluster.Spec.Replicas = 5
+			Expect(operator.checkScaleDown(cluster)).To(Succeed())
+		})
+
+		It("refuses to delete the current primary", func() {
+			cluster.Spec.Replicas = 2
+			err := operator.checkScaleDown(cluster)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("switch it over"))
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// MigrateStoredClusters re-writes stored MySQLCluster objects which predate
+// the current schema, applying defaulting and normalization so every stored
+// object carries the current shape. The schema version marker in the status
+// makes this a one-time migration per schema bump, so an operator upgrade
+// does not re-write objects on every start.
+func MigrateStoredClusters(namespace string, clientset versioned.Interface) error {
+	clustersInterface := clientset.CrV1().MySQLClusters(namespace)
+	clusters, err := clustersInterface.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	migrateErrs := []error{}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if cluster.Status.SchemaVersion == crv1.SchemaVersion {
+			continue
+		}
+
+		cluster.WithDefaults()
+		cluster.Status.SchemaVersion = crv1.SchemaVersion
+		_, err = clustersInterface.Update(cluster)
+		if err != nil {
+			migrateErrs = append(migrateErrs, err)
+			continue
+		}
+		logging.LogCluster(cluster).Info("Migrated to the current schema.")
+	}
+
+	return errors.NewAggregate(migrateErrs)
+}
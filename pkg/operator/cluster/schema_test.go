@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+)
+
+var _ = Describe("Schema", func() {
+	clusterNamed := func(name, schemaVersion string) *crv1.MySQLCluster {
+		cluster := new(crv1.MySQLCluster)
+		cluster.Name = name
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.Status.SchemaVersion = schemaVersion
+		return cluster
+	}
+
+	Describe("MigrateStoredClusters", func() {
+		It("normalizes and stamps clusters predating the schema", func() {
+			clientset := versioned.NewSimpleClientset(clusterNamed("old-cluster", ""))
+			Expect(MigrateStoredClusters(metav1.NamespaceDefault, clientset)).To(Succeed())
+
+			migrated, err := clientset.CrV1().MySQLClusters(metav1.NamespaceDefault).
+				Get("old-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated.Status.SchemaVersion).To(Equal(crv1.SchemaVersion))
+			Expect(migrated.Spec.Replicas).To(Equal(crv1.DefaultReplicas))
+		})
+
+		It("leaves already migrated clusters alone", func() {
+			clientset := versioned.NewSimpleClientset(
+				clusterNamed("current-cluster", crv1.SchemaVersion))
+			Expect(MigrateStoredClusters(metav1.NamespaceDefault, clientset)).To(Succeed())
+
+			current, err := clientset.CrV1().MySQLClusters(metav1.NamespaceDefault).
+				Get("current-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current.Spec.Replicas).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/logging"
+	"github.com/grtl/mysql-operator/pkg/util"
+)
+
+const serviceMonitorTemplate = "artifacts/cluster-servicemonitor.yaml"
+
+// serviceMonitorGroupVersion is the prometheus-operator API group serving
+// ServiceMonitor objects.
+var serviceMonitorGroupVersion = schema.GroupVersion{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+}
+
+// NewClusterOperatorForConfig returns a new Operator which is additionally
+// able to create prometheus-operator ServiceMonitor objects.
+func NewClusterOperatorForConfig(clientset versioned.Interface,
+	kubeClientset kubernetes.Interface, config *rest.Config) (Operator, error) {
+	confCopy := *config
+	confCopy.GroupVersion = &serviceMonitorGroupVersion
+	confCopy.APIPath = "/apis"
+
+	serviceMonitorClient, err := dynamic.NewClient(&confCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterOperator{
+		clientset:            clientset,
+		kubeClientset:        kubeClientset,
+		serviceMonitorClient: serviceMonitorClient,
+		restConfig:           config,
+	}, nil
+}
+
+// createServiceMonitor creates a ServiceMonitor for the cluster's metrics
+// service. It is skipped gracefully when the ServiceMonitor CRD is not
+// installed or no client for it is configured.
+func (c *clusterOperator) createServiceMonitor(cluster *crv1.MySQLCluster) error {
+	if c.serviceMonitorClient == nil || !c.hasServiceMonitorCRD() {
+		logging.LogCluster(cluster).Warn(
+			"ServiceMonitor CRD not available, skipping ServiceMonitor creation.")
+		return nil
+	}
+
+	serviceMonitor, err := serviceMonitorForCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	resource := &metav1.APIResource{Name: "servicemonitors", Namespaced: true}
+	_, err = c.serviceMonitorClient.Resource(resource, cluster.Namespace).Create(serviceMonitor)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	} else if apierrors.IsAlreadyExists(err) {
+		logging.LogCluster(cluster).Warn("ServiceMonitor for cluster already exists")
+	}
+
+	return nil
+}
+
+// hasServiceMonitorCRD checks via discovery whether the ServiceMonitor API
+// is served by the cluster.
+func (c *clusterOperator) hasServiceMonitorCRD() bool {
+	resources, err := c.kubeClientset.Discovery().
+		ServerResourcesForGroupVersion(serviceMonitorGroupVersion.String())
+	if err != nil {
+		return false
+	}
+
+	for _, resource := range resources.APIResources {
+		if resource.Name == "servicemonitors" {
+			return true
+		}
+	}
+	return false
+}
+
+func serviceMonitorForCluster(cluster *crv1.MySQLCluster) (*unstructured.Unstructured, error) {
+	serviceMonitor := new(unstructured.Unstructured)
+	err := util.ObjectFromTemplate(cluster, serviceMonitor, serviceMonitorTemplate, FuncMap)
+	return serviceMonitor, err
+}
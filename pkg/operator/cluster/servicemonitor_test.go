@@ -0,0 +1,47 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Service Monitor", func() {
+	var cluster *crv1.MySQLCluster
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Spec.Metrics = &crv1.MySQLClusterMetrics{
+			ScrapeInterval: "15s",
+			ServiceMonitor: true,
+		}
+	})
+
+	Describe("serviceMonitorForCluster", func() {
+		It("renders a ServiceMonitor for the metrics service", func() {
+			serviceMonitor, err := serviceMonitorForCluster(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceMonitor.GetKind()).To(Equal("ServiceMonitor"))
+			Expect(serviceMonitor.GetName()).To(Equal(MetricsServiceName(cluster.Name)))
+		})
+	})
+
+	Describe("createServiceMonitor", func() {
+		It("skips gracefully when no ServiceMonitor client is configured", func() {
+			operator := &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+			Expect(operator.createServiceMonitor(cluster)).To(Succeed())
+		})
+	})
+})
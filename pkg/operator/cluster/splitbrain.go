@@ -0,0 +1,144 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// CheckSplitBrain looks for members which accept writes besides the intended
+// primary - a split brain caused by a replication misconfiguration. It sets
+// the SplitBrain status, records a warning Event and, when FenceOnSplitBrain
+// is set, forces every writable member except the intended primary
+// read-only. Fencing never touches the intended primary, so the cluster
+// stays writable through it.
+func (c *clusterOperator) CheckSplitBrain(cluster *crv1.MySQLCluster) error {
+	if c.restConfig == nil {
+		// Without a rest config we cannot query the members.
+		return nil
+	}
+	if cluster.Spec.Replicas < 2 {
+		return nil
+	}
+
+	writable, err := c.writableMembers(cluster)
+	if err != nil {
+		return err
+	}
+
+	if len(writable) <= 1 {
+		if cluster.Status.SplitBrain {
+			cluster.Status.SplitBrain = false
+			_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+			return err
+		}
+		return nil
+	}
+
+	pods, err := c.kubeClientset.CoreV1().Pods(cluster.Namespace).
+		List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+	primary := primaryOrdinal(pods.Items)
+
+	message := fmt.Sprintf(
+		"Multiple writable members detected (ordinals %s), intended primary is %d",
+		ordinalList(writable), primary)
+	logging.LogCluster(cluster).Warn(message)
+	c.recordEvent(cluster, corev1.EventTypeWarning, "SplitBrain", message)
+
+	if cluster.Spec.FenceOnSplitBrain {
+		for _, ordinal := range writable {
+			if ordinal == primary {
+				continue
+			}
+			logging.LogCluster(cluster).WithField(
+				"ordinal", ordinal).Warn("Fencing writable member read-only")
+			err = c.execOnPod(cluster, ordinal, readOnlyCommand(true))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cluster.Status.SplitBrain = true
+	cluster.Status.Message = message
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// writableMembers returns the ordinals of the members which report
+// read_only off.
+func (c *clusterOperator) writableMembers(cluster *crv1.MySQLCluster) ([]int, error) {
+	writable := make([]int, 0, cluster.Spec.Replicas)
+	for ordinal := 0; ordinal < int(cluster.Spec.Replicas); ordinal++ {
+		output, err := c.queryPod(cluster, ordinal, "SELECT @@read_only")
+		if err != nil {
+			// An unreachable member cannot accept writes - skip it.
+			logging.LogCluster(cluster).WithField(
+				"ordinal", ordinal).Debug("Member not reachable for the split brain check.")
+			continue
+		}
+		if strings.TrimSpace(output) == "0" {
+			writable = append(writable, ordinal)
+		}
+	}
+
+	sort.Ints(writable)
+	return writable, nil
+}
+
+func ordinalList(ordinals []int) string {
+	parts := make([]string, 0, len(ordinals))
+	for _, ordinal := range ordinals {
+		parts = append(parts, fmt.Sprintf("%d", ordinal))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// recordEvent publishes an Event of the given type on the cluster.
+func (c *clusterOperator) recordEvent(cluster *crv1.MySQLCluster, eventType, reason, message string) {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			// Name the event explicitly, the same way the client-go event
+			// recorder does - the fake clientset does not expand GenerateName.
+			Name:      fmt.Sprintf("%s.%x", cluster.Name, now.UnixNano()),
+			Namespace: cluster.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:       "MySQLCluster",
+			APIVersion: crv1.SchemeGroupVersion.String(),
+			Name:       cluster.Name,
+			Namespace:  cluster.Namespace,
+			UID:        cluster.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         corev1.EventSource{Component: "mysql-operator"},
+	}
+
+	_, err := c.kubeClientset.CoreV1().Events(cluster.Namespace).Create(event)
+	if err != nil {
+		logging.LogCluster(cluster).WithField(
+			"fail", err).Warn("Unable to record the event")
+	}
+}
+
+// queryPod runs a SQL query on the pod with the given ordinal and returns
+// its output.
+func (c *clusterOperator) queryPod(cluster *crv1.MySQLCluster, ordinal int, sql string) (string, error) {
+	command := fmt.Sprintf(`mysql -N -h 127.0.0.1 -p"${MYSQL_ROOT_PASSWORD}" -e '%s'`, sql)
+	return c.execInPod(cluster, ordinal, command)
+}
@@ -0,0 +1,60 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("SplitBrain", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(),
+			kubeClientset: fake.NewSimpleClientset(),
+		}
+	})
+
+	It("is skipped without a rest config", func() {
+		Expect(operator.CheckSplitBrain(cluster)).To(Succeed())
+	})
+
+	Describe("recordEvent", func() {
+		It("publishes a warning event on the cluster", func() {
+			operator.recordEvent(cluster, corev1.EventTypeWarning, "SplitBrain", "multiple writable members")
+
+			events, err := operator.kubeClientset.CoreV1().
+				Events(cluster.Namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events.Items).To(HaveLen(1))
+
+			event := events.Items[0]
+			Expect(event.Type).To(Equal(corev1.EventTypeWarning))
+			Expect(event.Reason).To(Equal("SplitBrain"))
+			Expect(event.InvolvedObject.Name).To(Equal(cluster.Name))
+		})
+	})
+
+	Describe("ordinalList", func() {
+		It("joins the ordinals", func() {
+			Expect(ordinalList([]int{0, 2})).To(Equal("0, 2"))
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// checkStorageClass verifies the storage class the cluster references
+// actually exists, so a typo is reported at reconcile time instead of
+// leaving the pods stuck Pending on unbindable claims. An empty class name
+// uses the cluster default and is not checked.
+func (c *clusterOperator) checkStorageClass(cluster *crv1.MySQLCluster) error {
+	if cluster.Spec.StorageClassName == "" {
+		return nil
+	}
+
+	classInterface := c.kubeClientset.StorageV1().StorageClasses()
+	_, err := classInterface.Get(cluster.Spec.StorageClassName, metav1.GetOptions{})
+	if err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	classes, listErr := classInterface.List(metav1.ListOptions{})
+	if listErr != nil || len(classes.Items) == 0 {
+		return fmt.Errorf(
+			"cluster %q references the unknown storage class %q",
+			cluster.Name, cluster.Spec.StorageClassName)
+	}
+
+	names := make([]string, 0, len(classes.Items))
+	for _, class := range classes.Items {
+		names = append(names, class.Name)
+	}
+	return fmt.Errorf(
+		"cluster %q references the unknown storage class %q, available classes are: %s",
+		cluster.Name, cluster.Spec.StorageClassName, strings.Join(names, ", "))
+}
@@ -0,0 +1,74 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("StorageClass", func() {
+	var cluster *crv1.MySQLCluster
+
+	storageClass := func(name string) *storagev1.StorageClass {
+		class := new(storagev1.StorageClass)
+		class.Name = name
+		return class
+	}
+
+	operatorWith := func(classes ...*storagev1.StorageClass) *clusterOperator {
+		objects := make([]runtime.Object, 0, len(classes))
+		for _, class := range classes {
+			objects = append(objects, class)
+		}
+		return &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(objects...),
+		}
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("skips the check without a storage class name", func() {
+		operator := operatorWith()
+		Expect(operator.checkStorageClass(cluster)).To(Succeed())
+	})
+
+	It("accepts an existing storage class", func() {
+		cluster.Spec.StorageClassName = "fast-ssd"
+		operator := operatorWith(storageClass("fast-ssd"))
+		Expect(operator.checkStorageClass(cluster)).To(Succeed())
+	})
+
+	It("lists the available classes for an unknown one", func() {
+		cluster.Spec.StorageClassName = "fast-sdd"
+		operator := operatorWith(storageClass("fast-ssd"), storageClass("standard"))
+
+		err := operator.checkStorageClass(cluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`unknown storage class "fast-sdd"`))
+		Expect(err.Error()).To(ContainSubstring("fast-ssd"))
+		Expect(err.Error()).To(ContainSubstring("standard"))
+	})
+
+	It("reports an unknown class when no classes are defined", func() {
+		cluster.Spec.StorageClassName = "fast-ssd"
+		operator := operatorWith()
+
+		err := operator.checkStorageClass(cluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown storage class"))
+	})
+})
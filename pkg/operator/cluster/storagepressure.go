@@ -0,0 +1,132 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/config"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// storageUsageCommand prints the data volume usage as a percentage, e.g. 85%.
+const storageUsageCommand = `df -P /var/lib/mysql | tail -n 1 | awk '{print $5}'`
+
+// CheckStoragePressure reads every member's data volume usage and flips the
+// StoragePressure condition when the highest usage crosses the configured
+// threshold, so a disk fills up loudly instead of silently corrupting the
+// database. With AutoExpandStorage enabled the data volume claims are also
+// grown to buy headroom.
+func (c *clusterOperator) CheckStoragePressure(cluster *crv1.MySQLCluster) error {
+	return c.checkStoragePressure(cluster, config.Get().StoragePressureThreshold)
+}
+
+func (c *clusterOperator) checkStoragePressure(cluster *crv1.MySQLCluster, thresholdPercent int) error {
+	if thresholdPercent <= 0 || c.restConfig == nil {
+		// The check is disabled, or the operator cannot exec into pods.
+		return nil
+	}
+
+	worst := -1
+	worstOrdinal := 0
+	for ordinal := 0; ordinal < int(cluster.Spec.Replicas); ordinal++ {
+		output, err := c.podStorageUsage(cluster, ordinal)
+		if err != nil {
+			// A missing or restarting pod must not fail the whole check.
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to read the data volume usage")
+			continue
+		}
+		usage, err := parseUsagePercent(output)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to parse the data volume usage")
+			continue
+		}
+		if usage > worst {
+			worst = usage
+			worstOrdinal = ordinal
+		}
+	}
+	if worst < 0 {
+		// No member could be read - leave the condition as it is.
+		return nil
+	}
+
+	if worst >= thresholdPercent {
+		message := fmt.Sprintf("data volume of pod %s is %d%% full",
+			PodName(cluster.ResourceName(), worstOrdinal), worst)
+		cluster.SetCondition(crv1.ConditionStoragePressure, corev1.ConditionTrue, message)
+		c.recordEvent(cluster, corev1.EventTypeWarning, "StoragePressure", message)
+
+		if cluster.Spec.AutoExpandStorage {
+			err := c.expandStorage(cluster)
+			if err != nil {
+				logging.LogCluster(cluster).WithField(
+					"fail", err).Warn("Unable to expand the data volume claims")
+			}
+		}
+	} else {
+		cluster.SetCondition(crv1.ConditionStoragePressure, corev1.ConditionFalse, "")
+	}
+
+	_, err := c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// expandStorage grows every member's data volume claim by a quarter. The
+// resize only takes effect when the storage class allows volume expansion.
+func (c *clusterOperator) expandStorage(cluster *crv1.MySQLCluster) error {
+	pvcInterface := c.kubeClientset.CoreV1().PersistentVolumeClaims(cluster.Namespace)
+
+	var errs []error
+	for ordinal := 0; ordinal < int(cluster.Spec.Replicas); ordinal++ {
+		pvc, err := pvcInterface.Get(
+			DataPVCName(cluster.ResourceName(), ordinal), metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = expandedQuantity(
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+		_, err = pvcInterface.Update(pvc)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.NewAggregate(errs)
+}
+
+// expandedQuantity returns the quantity grown by a quarter.
+func expandedQuantity(current resource.Quantity) resource.Quantity {
+	value := current.Value()
+	return *resource.NewQuantity(value+value/4, resource.BinarySI)
+}
+
+// parseUsagePercent parses the df usage output, e.g. "85%".
+func parseUsagePercent(output string) (int, error) {
+	trimmed := strings.TrimSpace(output)
+	usage, err := strconv.Atoi(strings.TrimSuffix(trimmed, "%"))
+	if err != nil {
+		return 0, fmt.Errorf("unexpected df output %q", trimmed)
+	}
+	return usage, nil
+}
+
+// podStorageUsage reads the data volume usage of the pod with the given
+// ordinal.
+func (c *clusterOperator) podStorageUsage(cluster *crv1.MySQLCluster, ordinal int) (string, error) {
+	return c.execInPod(cluster, ordinal, storageUsageCommand)
+}
@@ -0,0 +1,80 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Storage pressure", func() {
+	Describe("parseUsagePercent", func() {
+		It("parses the df output", func() {
+			Expect(parseUsagePercent("85%\n")).To(Equal(85))
+		})
+
+		It("rejects unexpected output", func() {
+			_, err := parseUsagePercent("df: /var/lib/mysql: No such file")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("expandedQuantity", func() {
+		It("grows the quantity by a quarter", func() {
+			expanded := expandedQuantity(resource.MustParse("8Gi"))
+			Expect(expanded.Value()).To(Equal(int64(10 * 1024 * 1024 * 1024)))
+		})
+	})
+
+	Describe("expandStorage", func() {
+		var (
+			cluster  *crv1.MySQLCluster
+			operator *clusterOperator
+		)
+
+		BeforeEach(func() {
+			cluster = new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			cluster.Namespace = metav1.NamespaceDefault
+			cluster.Spec.Replicas = 1
+
+			pvc := new(corev1.PersistentVolumeClaim)
+			pvc.Name = DataPVCName(cluster.ResourceName(), 0)
+			pvc.Namespace = metav1.NamespaceDefault
+			pvc.Spec.Resources.Requests = corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("8Gi"),
+			}
+
+			operator = &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(cluster),
+				kubeClientset: fake.NewSimpleClientset(pvc),
+			}
+		})
+
+		It("grows the data volume claims", func() {
+			Expect(operator.expandStorage(cluster)).To(Succeed())
+
+			pvc, err := operator.kubeClientset.CoreV1().
+				PersistentVolumeClaims(metav1.NamespaceDefault).
+				Get(DataPVCName(cluster.ResourceName(), 0), metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			request := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			Expect(request.Value()).To(Equal(int64(10 * 1024 * 1024 * 1024)))
+		})
+
+		It("tolerates a missing claim", func() {
+			cluster.Spec.Replicas = 3
+			Expect(operator.expandStorage(cluster)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package cluster
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// applyUnsupportedFields patches the stateful set fields the vendored client
+// libraries predate - the pod readiness gates and minReadySeconds - onto the
+// live object. They cannot ride on the typed object, so they are applied
+// with a raw patch instead - and re-applied after every typed update, which
+// silently drops them.
+func (c *clusterOperator) applyUnsupportedFields(cluster *crv1.MySQLCluster) error {
+	spec := map[string]interface{}{}
+	if cluster.Spec.MinReadySeconds > 0 {
+		spec["minReadySeconds"] = cluster.Spec.MinReadySeconds
+	}
+	if len(cluster.Spec.ReadinessGates) > 0 {
+		spec["template"] = map[string]interface{}{
+			"spec": map[string]interface{}{
+				"readinessGates": cluster.Spec.ReadinessGates,
+			},
+		}
+	}
+	if len(spec) == 0 {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.kubeClientset.AppsV1().StatefulSets(cluster.Namespace).
+		Patch(StatefulSetName(cluster.ResourceName()), types.StrategicMergePatchType, patch)
+	return err
+}
@@ -0,0 +1,60 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("UpdateGuard", func() {
+	var (
+		cluster       *crv1.MySQLCluster
+		operator      *clusterOperator
+		kubeClientset *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.WithDefaults()
+
+		live, err := statefulSetForCluster(cluster, nil)
+		Expect(err).NotTo(HaveOccurred())
+		live.Namespace = cluster.Namespace
+
+		kubeClientset = fake.NewSimpleClientset(live)
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(),
+			kubeClientset: kubeClientset,
+		}
+	})
+
+	statefulSetUpdates := func() int {
+		updates := 0
+		for _, action := range kubeClientset.Actions() {
+			if action.Matches("update", "statefulsets") {
+				updates++
+			}
+		}
+		return updates
+	}
+
+	It("skips the update when the stateful set is unchanged", func() {
+		Expect(operator.updateStatefulSet(cluster, 0)).To(Succeed())
+		Expect(statefulSetUpdates()).To(Equal(0))
+	})
+
+	It("updates the stateful set when the spec changed", func() {
+		cluster.Spec.Image = "mysql:8.0"
+		Expect(operator.updateStatefulSet(cluster, 0)).To(Succeed())
+		Expect(statefulSetUpdates()).To(Equal(1))
+	})
+})
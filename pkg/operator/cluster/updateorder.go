@@ -0,0 +1,118 @@
+package cluster
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// RoleAnnotation is a pod annotation holding the replication role of a
+// cluster member. Without it the member at ordinal 0 is assumed primary.
+const RoleAnnotation = "cr.mysqloperator.grtl.github.com/role"
+
+// Available RoleAnnotation values.
+const (
+	RolePrimary = "primary"
+	RoleReplica = "replica"
+)
+
+// RoleDraining marks a pod a scale-down is about to remove. It is only used
+// as a label value - a draining pod no longer matches the read service
+// selector, so its connections can finish before the pod is deleted.
+const RoleDraining = "draining"
+
+// RoleLabel is a pod label mirroring the replication role, so services can
+// select cluster members by role.
+const RoleLabel = "role"
+
+// labelPodRoles keeps the role label on the cluster pods in sync with the
+// replication role, so the read service selector can target replicas only.
+func (c *clusterOperator) labelPodRoles(cluster *crv1.MySQLCluster, primary int) error {
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	pods, err := podInterface.List(listOptionsForCluster(cluster))
+	if err != nil {
+		return err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil {
+			continue
+		}
+		// Pods beyond the spec replica count are about to be removed and
+		// may be draining - leave their role label alone.
+		if int32(ordinal) >= cluster.Spec.Replicas {
+			continue
+		}
+
+		role := RoleReplica
+		if ordinal == primary {
+			role = RolePrimary
+		}
+		if pod.Labels[RoleLabel] == role {
+			continue
+		}
+
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[RoleLabel] = role
+		_, err = podInterface.Update(pod)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// primaryOrdinal returns the ordinal of the pod annotated as primary,
+// falling back to 0 when no pod carries the role annotation.
+func primaryOrdinal(pods []corev1.Pod) int {
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Annotations[RoleAnnotation] != RolePrimary {
+			continue
+		}
+		ordinal, err := PodOrdinal(pod.Name)
+		if err != nil {
+			continue
+		}
+		return ordinal
+	}
+	return 0
+}
+
+// updateOrder returns the pod names in the order a rolling update should
+// process them: highest ordinal first, with the primary moved to the very
+// end to minimize write downtime.
+func updateOrder(clusterName string, replicas int32, primary int) []string {
+	order := make([]string, 0, replicas)
+	for ordinal := int(replicas) - 1; ordinal >= 0; ordinal-- {
+		if ordinal == primary {
+			continue
+		}
+		order = append(order, PodName(clusterName, ordinal))
+	}
+	return append(order, PodName(clusterName, primary))
+}
+
+// recordUpdateOrder computes the primary-last update order for the cluster
+// and records it in the status.
+func (c *clusterOperator) recordUpdateOrder(cluster *crv1.MySQLCluster) (int, error) {
+	podInterface := c.kubeClientset.CoreV1().Pods(cluster.Namespace)
+	pods, err := podInterface.List(listOptionsForCluster(cluster))
+	if err != nil {
+		return 0, err
+	}
+
+	primary := primaryOrdinal(pods.Items)
+	order := updateOrder(cluster.ResourceName(), cluster.Spec.Replicas, primary)
+
+	cluster.Status.UpdateOrder = strings.Join(order, ",")
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return primary, err
+}
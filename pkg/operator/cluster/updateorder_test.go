@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func podWithRole(name, role string) corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	if role != "" {
+		pod.Annotations = map[string]string{
+			RoleAnnotation: role,
+		}
+	}
+	return pod
+}
+
+var _ = Describe("Update Order", func() {
+	Describe("primaryOrdinal", func() {
+		It("returns the ordinal of the pod annotated as primary", func() {
+			pods := []corev1.Pod{
+				podWithRole("my-cluster-0", RoleReplica),
+				podWithRole("my-cluster-1", RolePrimary),
+				podWithRole("my-cluster-2", RoleReplica),
+			}
+			Expect(primaryOrdinal(pods)).To(Equal(1))
+		})
+
+		It("falls back to 0 when no pod carries the role annotation", func() {
+			pods := []corev1.Pod{
+				podWithRole("my-cluster-0", ""),
+				podWithRole("my-cluster-1", ""),
+			}
+			Expect(primaryOrdinal(pods)).To(Equal(0))
+		})
+	})
+
+	Describe("updateOrder", func() {
+		It("lists pods highest ordinal first with the primary last", func() {
+			Expect(updateOrder("my-cluster", 3, 1)).To(Equal([]string{
+				"my-cluster-2", "my-cluster-0", "my-cluster-1",
+			}))
+		})
+
+		It("keeps the default order when the primary is at ordinal 0", func() {
+			Expect(updateOrder("my-cluster", 3, 0)).To(Equal([]string{
+				"my-cluster-2", "my-cluster-1", "my-cluster-0",
+			}))
+		})
+	})
+})
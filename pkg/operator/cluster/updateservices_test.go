@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("updateServices", func() {
+	var (
+		cluster       *crv1.MySQLCluster
+		operator      *clusterOperator
+		kubeClientset *fake.Clientset
+	)
+
+	writeService := func() *corev1.Service {
+		service, err := kubeClientset.CoreV1().Services(metav1.NamespaceDefault).
+			Get(ServiceName(cluster.ResourceName()), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return service
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.WithDefaults()
+
+		kubeClientset = fake.NewSimpleClientset()
+		operator = &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: kubeClientset,
+		}
+
+		for _, template := range []string{serviceTemplate, serviceReadTemplate} {
+			service, err := serviceForCluster(cluster, template)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = kubeClientset.CoreV1().Services(metav1.NamespaceDefault).
+				Create(service)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("updates both services", func() {
+		cluster.Spec.Port = 3310
+		Expect(operator.updateServices(cluster)).To(Succeed())
+		Expect(writeService().Spec.Ports[0].Port).To(Equal(int32(3310)))
+	})
+
+	When("the read service update fails", func() {
+		BeforeEach(func() {
+			kubeClientset.PrependReactor("update", "services",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					service := action.(k8stesting.UpdateAction).
+						GetObject().(*corev1.Service)
+					if service.Name == ReadServiceName(cluster.ResourceName()) {
+						return true, nil, fmt.Errorf("read service update denied")
+					}
+					return false, nil, nil
+				})
+		})
+
+		It("restores the write service to its prior state", func() {
+			priorPort := writeService().Spec.Ports[0].Port
+
+			cluster.Spec.Port = 3310
+			err := operator.updateServices(cluster)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("read service update denied"))
+
+			Expect(writeService().Spec.Ports[0].Port).To(Equal(priorPort))
+		})
+
+		It("records the rollback in the audit log", func() {
+			cluster.Spec.Port = 3310
+			Expect(operator.updateServices(cluster)).NotTo(Succeed())
+
+			Expect(cluster.Status.AuditLog).NotTo(BeEmpty())
+			last := cluster.Status.AuditLog[len(cluster.Status.AuditLog)-1]
+			Expect(last.Action).To(Equal("Rollback"))
+		})
+	})
+})
@@ -2,18 +2,37 @@ package cluster
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"text/template"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
 	"github.com/grtl/mysql-operator/pkg/operator/backupschedule"
 )
 
 // FuncMap can be used to execute templates with the helper functions from
 // the cluster operator.
 var FuncMap = template.FuncMap{
-	"PVCName":         backupschedule.PVCName,
-	"StatefulSetName": StatefulSetName,
-	"ServiceName":     ServiceName,
-	"ReadServiceName": ReadServiceName,
+	"Image":                     Image,
+	"PVCName":                   backupschedule.PVCName,
+	"StatefulSetName":           StatefulSetName,
+	"ServiceName":               ServiceName,
+	"ReadServiceName":           ReadServiceName,
+	"ReadServiceSelectsPrimary": ReadServiceSelectsPrimary,
+	"MetricsServiceName":        MetricsServiceName,
+	"ProxyName":                 ProxyName,
+	"ProxyConfigMapName":        ProxyConfigMapName,
+	"InitScriptConfigMapName":   InitScriptConfigMapName,
+	"MigrationJobName":          MigrationJobName,
+	"ConfigConfigMapName":       ConfigConfigMapName,
+	"LivenessProbeCommand":      LivenessProbeCommand,
+	"ReadinessProbeCommand":     ReadinessProbeCommand,
+	"FlavorConfig":              FlavorConfig,
+	"TimeZoneIsOffset":          crv1.TimeZoneIsOffset,
+	"MetricsResources":          metricsResources,
 }
 
 // StatefulSetName returns a name for the stateful set associated with the
@@ -33,3 +52,98 @@ func ServiceName(clusterName string) string {
 func ReadServiceName(clusterName string) string {
 	return fmt.Sprintf("%s-read", clusterName)
 }
+
+// ReadServiceSelectsPrimary returns true when the read service selector
+// should match the primary pod as well: either always, when requested via
+// ReadServiceIncludesPrimary, or as a fallback when the cluster has no
+// replicas to read from.
+func ReadServiceSelectsPrimary(cluster *crv1.MySQLCluster) bool {
+	return cluster.Spec.ReadServiceIncludesPrimary || cluster.Spec.Replicas <= 1
+}
+
+// MetricsServiceName returns a name for the metrics service associated with
+// the given clusterName.
+func MetricsServiceName(clusterName string) string {
+	return fmt.Sprintf("%s-metrics", clusterName)
+}
+
+// ProxyName returns a name for the ProxySQL deployment and service
+// associated with the given clusterName.
+func ProxyName(clusterName string) string {
+	return fmt.Sprintf("%s-proxy", clusterName)
+}
+
+// ProxyConfigMapName returns a name for the config map holding the ProxySQL
+// configuration of the given clusterName.
+func ProxyConfigMapName(clusterName string) string {
+	return fmt.Sprintf("%s-proxy-config", clusterName)
+}
+
+// InitScriptConfigMapName returns a name for the config map holding the
+// inline init script of the given clusterName.
+func InitScriptConfigMapName(clusterName string) string {
+	return fmt.Sprintf("%s-init", clusterName)
+}
+
+// ConfigConfigMapName returns a name for the config map holding the inline
+// server configuration of the given clusterName.
+func ConfigConfigMapName(clusterName string) string {
+	return fmt.Sprintf("%s-config", clusterName)
+}
+
+// MigrationJobName returns a name for the migration gate job associated with
+// the given clusterName.
+func MigrationJobName(clusterName string) string {
+	return fmt.Sprintf("%s-migration", clusterName)
+}
+
+// ReplicationLagAnnotation is a pod annotation holding the replication lag
+// (in seconds) behind the primary. It may be published for replica pods and
+// is used to pick the least lagging replica.
+const ReplicationLagAnnotation = "cr.mysqloperator.grtl.github.com/replication-lag"
+
+// PodName returns a name for the pod with the given ordinal in the stateful
+// set associated with the given clusterName.
+func PodName(clusterName string, ordinal int) string {
+	return fmt.Sprintf("%s-%d", StatefulSetName(clusterName), ordinal)
+}
+
+// PodAddress returns an in-cluster DNS address of the pod with the given
+// ordinal in the stateful set associated with the given clusterName.
+func PodAddress(clusterName string, ordinal int) string {
+	return fmt.Sprintf("%s.%s", PodName(clusterName, ordinal), ServiceName(clusterName))
+}
+
+// PodOrdinal extracts the stateful set ordinal from the given podName.
+func PodOrdinal(podName string) (int, error) {
+	index := strings.LastIndex(podName, "-")
+	if index == -1 {
+		return 0, fmt.Errorf("pod %q has no ordinal suffix", podName)
+	}
+	return strconv.Atoi(podName[index+1:])
+}
+
+// IsPodReady returns true when the given pod reports the Ready condition.
+func IsPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// listOptionsForCluster returns list options selecting the pods of the given
+// cluster.
+func listOptionsForCluster(cluster *crv1.MySQLCluster) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", cluster.Name),
+	}
+}
+
+// DataPVCName returns a name for the data Persistent Volume Claim of the pod
+// with the given ordinal in the stateful set associated with the given
+// clusterName.
+func DataPVCName(clusterName string, ordinal int) string {
+	return fmt.Sprintf("data-%s", PodName(clusterName, ordinal))
+}
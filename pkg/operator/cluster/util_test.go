@@ -31,4 +31,32 @@ var _ = Describe("Util", func() {
 			Expect(ReadServiceName(anotherClusterName)).To(Equal("another-cluster-read"))
 		})
 	})
+
+	Describe("MetricsServiceName", func() {
+		It("should generate a name for the metrics service", func() {
+			Expect(MetricsServiceName(clusterName)).To(Equal("my-cluster-metrics"))
+			Expect(MetricsServiceName(anotherClusterName)).To(Equal("another-cluster-metrics"))
+		})
+	})
+
+	Describe("PodName", func() {
+		It("should generate a name for the pod with the given ordinal", func() {
+			Expect(PodName(clusterName, 0)).To(Equal("my-cluster-0"))
+			Expect(PodName(anotherClusterName, 2)).To(Equal("another-cluster-2"))
+		})
+	})
+
+	Describe("DataPVCName", func() {
+		It("should generate a name for the data PVC of the given pod", func() {
+			Expect(DataPVCName(clusterName, 0)).To(Equal("data-my-cluster-0"))
+			Expect(DataPVCName(anotherClusterName, 2)).To(Equal("data-another-cluster-2"))
+		})
+	})
+
+	Describe("PodAddress", func() {
+		It("should generate an address for the pod with the given ordinal", func() {
+			Expect(PodAddress(clusterName, 0)).To(Equal("my-cluster-0.my-cluster"))
+			Expect(PodAddress(anotherClusterName, 2)).To(Equal("another-cluster-2.another-cluster"))
+		})
+	})
 })
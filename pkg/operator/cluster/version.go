@@ -0,0 +1,64 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+)
+
+// versionCommand queries the server version over the local socketless
+// connection, skipping the column header.
+const versionCommand = `mysql -h 127.0.0.1 -p"${MYSQL_ROOT_PASSWORD}" -N -e 'SELECT VERSION()'`
+
+// reportRunningVersion queries the primary for the MySQL version it actually
+// runs and records it in the cluster status. A divergence from the version
+// requested by the spec image is surfaced as a VersionMismatch state.
+func (c *clusterOperator) reportRunningVersion(cluster *crv1.MySQLCluster) error {
+	if c.restConfig == nil {
+		// Without a rest config we cannot exec into pods.
+		return nil
+	}
+
+	version, err := c.queryVersion(cluster)
+	if err != nil {
+		return err
+	}
+
+	cluster.Status.RunningVersion = version
+	if versionMismatch(cluster.Spec.Image, version) {
+		return c.setClusterState(cluster, "VersionMismatch", fmt.Sprintf(
+			"primary reports version %q while the spec requests image %q",
+			version, cluster.Spec.Image))
+	}
+
+	_, err = c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// queryVersion runs SELECT VERSION() on the primary pod.
+func (c *clusterOperator) queryVersion(cluster *crv1.MySQLCluster) (string, error) {
+	output, err := c.execInPod(cluster, 0, versionCommand)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+// versionMismatch reports whether the running version diverges from the
+// version requested by the spec image tag. Untagged and latest images make
+// no version promise and never mismatch.
+func versionMismatch(image, runningVersion string) bool {
+	index := strings.LastIndex(image, ":")
+	if index == -1 {
+		return false
+	}
+
+	tag := strings.TrimPrefix(image[index+1:], "v")
+	if tag == "" || tag == "latest" {
+		return false
+	}
+
+	return !strings.HasPrefix(runningVersion, tag)
+}
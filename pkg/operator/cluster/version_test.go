@@ -0,0 +1,47 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Running Version", func() {
+	Describe("versionMismatch", func() {
+		It("should report a mismatch when the tag diverges", func() {
+			Expect(versionMismatch("mysql:8.0", "5.7.21-log")).To(BeTrue())
+			Expect(versionMismatch("mysql:v8.0", "5.7.21-log")).To(BeTrue())
+		})
+
+		It("should not report a mismatch when the tag matches", func() {
+			Expect(versionMismatch("mysql:5.7", "5.7.21-log")).To(BeFalse())
+			Expect(versionMismatch("mysql:v5.7", "5.7.21-log")).To(BeFalse())
+		})
+
+		It("should never mismatch on untagged or latest images", func() {
+			Expect(versionMismatch("mysql", "5.7.21-log")).To(BeFalse())
+			Expect(versionMismatch("mysql:latest", "5.7.21-log")).To(BeFalse())
+		})
+	})
+
+	Describe("reportRunningVersion", func() {
+		It("is skipped when no rest config is configured", func() {
+			cluster := new(crv1.MySQLCluster)
+			err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			operator := &clusterOperator{
+				clientset:     versioned.NewSimpleClientset(),
+				kubeClientset: fake.NewSimpleClientset(),
+			}
+			Expect(operator.reportRunningVersion(cluster)).To(Succeed())
+		})
+	})
+})
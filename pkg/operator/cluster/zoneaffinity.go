@@ -0,0 +1,111 @@
+package cluster
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/logging"
+)
+
+// ZoneLabel is the well-known label nodes and persistent volumes carry their
+// availability zone in.
+const ZoneLabel = "failure-domain.beta.kubernetes.io/zone"
+
+// CheckZoneAffinity compares every member pod's zone with the zone of its
+// bound data volume and flips the ZoneConflict condition when they differ,
+// e.g. after a failover rescheduled the primary across zones. The scheduler's
+// volume zone predicate keeps such a pod from landing elsewhere on its next
+// reschedule, so the mismatch is surfaced loudly instead of turning into a
+// pod stuck in Pending later.
+func (c *clusterOperator) CheckZoneAffinity(cluster *crv1.MySQLCluster) error {
+	conflict := ""
+	checked := false
+	for ordinal := 0; ordinal < int(cluster.Spec.Replicas); ordinal++ {
+		podZone, err := c.podZone(cluster, ordinal)
+		if err != nil {
+			// A missing or unscheduled pod must not fail the whole check.
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to read the pod's zone")
+			continue
+		}
+		volumeZone, err := c.volumeZone(cluster, ordinal)
+		if err != nil {
+			logging.LogCluster(cluster).WithField(
+				"fail", err).Warn("Unable to read the data volume's zone")
+			continue
+		}
+		if podZone == "" || volumeZone == "" {
+			// The node or volume is not zone-labeled - nothing to compare.
+			continue
+		}
+
+		checked = true
+		if podZone != volumeZone {
+			conflict = fmt.Sprintf("pod %s runs in zone %q but its data volume is in zone %q",
+				PodName(cluster.ResourceName(), ordinal), podZone, volumeZone)
+			break
+		}
+	}
+	if !checked {
+		// No member could be compared - leave the condition as it is.
+		return nil
+	}
+
+	if conflict != "" {
+		cluster.SetCondition(crv1.ConditionZoneConflict, corev1.ConditionTrue, conflict)
+		c.recordEvent(cluster, corev1.EventTypeWarning, "ZoneConflict", conflict)
+	} else {
+		cluster.SetCondition(crv1.ConditionZoneConflict, corev1.ConditionFalse, "")
+	}
+
+	_, err := c.clientset.CrV1().MySQLClusters(cluster.Namespace).Update(cluster)
+	return err
+}
+
+// podZone returns the zone of the node the pod with the given ordinal runs
+// on, or "" when the pod is unscheduled or the node carries no zone label.
+func (c *clusterOperator) podZone(cluster *crv1.MySQLCluster, ordinal int) (string, error) {
+	pod, err := c.kubeClientset.CoreV1().Pods(cluster.Namespace).
+		Get(PodName(cluster.ResourceName(), ordinal), metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if pod.Spec.NodeName == "" {
+		return "", nil
+	}
+
+	node, err := c.kubeClientset.CoreV1().Nodes().Get(pod.Spec.NodeName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return node.Labels[ZoneLabel], nil
+}
+
+// volumeZone returns the zone of the persistent volume bound to the data
+// claim of the pod with the given ordinal, or "" when the claim is unbound or
+// the volume carries no zone label. A claim that does not exist yet is not an
+// error.
+func (c *clusterOperator) volumeZone(cluster *crv1.MySQLCluster, ordinal int) (string, error) {
+	pvc, err := c.kubeClientset.CoreV1().PersistentVolumeClaims(cluster.Namespace).
+		Get(DataPVCName(cluster.ResourceName(), ordinal), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if pvc.Spec.VolumeName == "" {
+		return "", nil
+	}
+
+	volume, err := c.kubeClientset.CoreV1().PersistentVolumes().
+		Get(pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return volume.Labels[ZoneLabel], nil
+}
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Zone affinity", func() {
+	var (
+		cluster  *crv1.MySQLCluster
+		operator *clusterOperator
+	)
+
+	// memberInZones builds the member pod with the given ordinal on a node in
+	// podZone, with its data claim bound to a volume in volumeZone.
+	memberInZones := func(ordinal int, podZone, volumeZone string) []runtime.Object {
+		node := new(corev1.Node)
+		node.Name = PodName(cluster.ResourceName(), ordinal) + "-node"
+		node.Labels = map[string]string{ZoneLabel: podZone}
+
+		pod := new(corev1.Pod)
+		pod.Name = PodName(cluster.ResourceName(), ordinal)
+		pod.Namespace = metav1.NamespaceDefault
+		pod.Spec.NodeName = node.Name
+
+		volume := new(corev1.PersistentVolume)
+		volume.Name = PodName(cluster.ResourceName(), ordinal) + "-pv"
+		volume.Labels = map[string]string{ZoneLabel: volumeZone}
+
+		pvc := new(corev1.PersistentVolumeClaim)
+		pvc.Name = DataPVCName(cluster.ResourceName(), ordinal)
+		pvc.Namespace = metav1.NamespaceDefault
+		pvc.Spec.VolumeName = volume.Name
+
+		return []runtime.Object{node, pod, volume, pvc}
+	}
+
+	operatorWith := func(objects []runtime.Object) *clusterOperator {
+		return &clusterOperator{
+			clientset:     versioned.NewSimpleClientset(cluster),
+			kubeClientset: fake.NewSimpleClientset(objects...),
+		}
+	}
+
+	clusterCondition := func(conditionType string) *crv1.MySQLClusterCondition {
+		updated, err := operator.clientset.CrV1().
+			MySQLClusters(metav1.NamespaceDefault).Get(cluster.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return updated.Condition(conditionType)
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.Spec.Replicas = 1
+	})
+
+	It("flags a pod outside its data volume's zone", func() {
+		operator = operatorWith(memberInZones(0, "zone-b", "zone-a"))
+
+		Expect(operator.CheckZoneAffinity(cluster)).To(Succeed())
+
+		condition := clusterCondition(crv1.ConditionZoneConflict)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("zone-a"))
+		Expect(condition.Message).To(ContainSubstring("zone-b"))
+	})
+
+	It("clears the condition when the zones match", func() {
+		operator = operatorWith(memberInZones(0, "zone-a", "zone-a"))
+
+		Expect(operator.CheckZoneAffinity(cluster)).To(Succeed())
+
+		condition := clusterCondition(crv1.ConditionZoneConflict)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("leaves the condition alone when no member can be compared", func() {
+		operator = operatorWith(nil)
+
+		Expect(operator.CheckZoneAffinity(cluster)).To(Succeed())
+
+		Expect(clusterCondition(crv1.ConditionZoneConflict)).To(BeNil())
+	})
+})
@@ -0,0 +1,269 @@
+// Package selftest drives a MySQLCluster through its full lifecycle -
+// create, update, scale, backup, delete - and asserts the expected
+// resources after every step. It codifies the operator's end-to-end
+// behavior in one entrypoint: CI runs it against the fake clientsets,
+// and the same function doubles as a smoke test in a disposable cluster
+// when real clientsets are passed in.
+package selftest
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/operator/backupinstance"
+	"github.com/grtl/mysql-operator/pkg/operator/cluster"
+)
+
+// Names of the objects the self-test creates. They are fixed so a failed
+// run can be cleaned up by hand.
+const (
+	ClusterName  = "operator-self-test"
+	SecretName   = "operator-self-test-secret"
+	ScheduleName = "operator-self-test-schedule"
+	BackupName   = "operator-self-test-backup"
+)
+
+// Run executes the lifecycle steps in order against the given clientsets
+// and returns the first failed step's error, prefixed with the step name.
+func Run(clientset versioned.Interface, kubeClientset kubernetes.Interface, namespace string) error {
+	t := &selfTest{
+		clientset:       clientset,
+		kubeClientset:   kubeClientset,
+		namespace:       namespace,
+		clusterOperator: cluster.NewClusterOperator(clientset, kubeClientset),
+		backupOperator:  backupinstance.NewBackupInstanceOperator(clientset, kubeClientset),
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"create", t.create},
+		{"update", t.update},
+		{"scale", t.scale},
+		{"backup", t.backup},
+		{"delete", t.delete},
+	}
+
+	for _, step := range steps {
+		err := step.run()
+		if err != nil {
+			return fmt.Errorf("self-test step %q: %v", step.name, err)
+		}
+	}
+
+	return nil
+}
+
+type selfTest struct {
+	clientset       versioned.Interface
+	kubeClientset   kubernetes.Interface
+	namespace       string
+	clusterOperator cluster.Operator
+	backupOperator  backupinstance.Operator
+
+	cluster *crv1.MySQLCluster
+}
+
+// create adds the cluster and asserts the stateful set and both services
+// came up.
+func (t *selfTest) create() error {
+	t.cluster = &crv1.MySQLCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClusterName,
+			Namespace: t.namespace,
+		},
+		Spec: crv1.MySQLClusterSpec{
+			Secret:   SecretName,
+			Storage:  resource.MustParse("1Gi"),
+			Replicas: 2,
+		},
+	}
+
+	created, err := t.clientset.CrV1().MySQLClusters(t.namespace).Create(t.cluster)
+	if err != nil {
+		return err
+	}
+	t.cluster = created
+
+	err = t.clusterOperator.AddCluster(t.cluster)
+	if err != nil {
+		return err
+	}
+
+	err = t.expectReplicas(2)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{
+		cluster.ServiceName(t.cluster.ResourceName()),
+		cluster.ReadServiceName(t.cluster.ResourceName()),
+	} {
+		_, err = t.kubeClientset.CoreV1().Services(t.namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// update changes the image and asserts the stateful set picked it up.
+func (t *selfTest) update() error {
+	t.cluster.Spec.Image = "mysql:8.0"
+	err := t.updateCluster()
+	if err != nil {
+		return err
+	}
+
+	statefulSet, err := t.kubeClientset.AppsV1().StatefulSets(t.namespace).
+		Get(cluster.StatefulSetName(t.cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	expected := cluster.Image(t.cluster.Spec.Image)
+	for _, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name == "mysql" {
+			if container.Image != expected {
+				return fmt.Errorf(
+					"expected the mysql container to run image %q, got %q",
+					expected, container.Image)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the stateful set has no mysql container")
+}
+
+// scale grows the cluster by one replica and asserts the stateful set
+// followed.
+func (t *selfTest) scale() error {
+	t.cluster.Spec.Replicas = 3
+	err := t.updateCluster()
+	if err != nil {
+		return err
+	}
+
+	return t.expectReplicas(3)
+}
+
+// backup schedules a backup of the cluster and asserts the create job
+// exists.
+func (t *selfTest) backup() error {
+	schedule := &crv1.MySQLBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ScheduleName,
+			Namespace: t.namespace,
+		},
+		Spec: crv1.MySQLBackupScheduleSpec{
+			Cluster: ClusterName,
+			Time:    "0 0 * * *",
+			Storage: resource.MustParse("1Gi"),
+		},
+	}
+	_, err := t.clientset.CrV1().MySQLBackupSchedules(t.namespace).Create(schedule)
+	if err != nil {
+		return err
+	}
+
+	backup := &crv1.MySQLBackupInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupName,
+			Namespace: t.namespace,
+		},
+		Spec: crv1.MySQLBackupInstanceSpec{
+			Schedule: ScheduleName,
+			Cluster:  ClusterName,
+		},
+		Status: crv1.MySQLBackupInstanceStatus{
+			Phase: crv1.MySQLBackupScheduled,
+		},
+	}
+	created, err := t.clientset.CrV1().MySQLBackupInstances(t.namespace).Create(backup)
+	if err != nil {
+		return err
+	}
+
+	err = t.backupOperator.CreateBackup(created)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.kubeClientset.BatchV1().Jobs(t.namespace).
+		Get(backupinstance.JobCreateName(BackupName), metav1.GetOptions{})
+	return err
+}
+
+// delete removes the cluster and asserts the stateful set and services
+// are gone.
+func (t *selfTest) delete() error {
+	err := t.clusterOperator.DeleteCluster(t.cluster)
+	if err != nil {
+		return err
+	}
+
+	for _, check := range []func() error{
+		func() error {
+			_, err := t.kubeClientset.AppsV1().StatefulSets(t.namespace).
+				Get(cluster.StatefulSetName(t.cluster.ResourceName()), metav1.GetOptions{})
+			return err
+		},
+		func() error {
+			_, err := t.kubeClientset.CoreV1().Services(t.namespace).
+				Get(cluster.ServiceName(t.cluster.ResourceName()), metav1.GetOptions{})
+			return err
+		},
+		func() error {
+			_, err := t.kubeClientset.CoreV1().Services(t.namespace).
+				Get(cluster.ReadServiceName(t.cluster.ResourceName()), metav1.GetOptions{})
+			return err
+		},
+	} {
+		err = check()
+		if err == nil {
+			return fmt.Errorf("a cluster resource survived the deletion")
+		}
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateCluster persists the in-memory spec change and reconciles it.
+func (t *selfTest) updateCluster() error {
+	updated, err := t.clientset.CrV1().MySQLClusters(t.namespace).Update(t.cluster)
+	if err != nil {
+		return err
+	}
+	t.cluster = updated
+
+	return t.clusterOperator.UpdateCluster(t.cluster)
+}
+
+// expectReplicas asserts the stateful set carries the given replica count.
+func (t *selfTest) expectReplicas(replicas int32) error {
+	statefulSet, err := t.kubeClientset.AppsV1().StatefulSets(t.namespace).
+		Get(cluster.StatefulSetName(t.cluster.ResourceName()), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if statefulSet.Spec.Replicas == nil || *statefulSet.Spec.Replicas != replicas {
+		return fmt.Errorf(
+			"expected the stateful set to have %d replicas, got %v",
+			replicas, statefulSet.Spec.Replicas)
+	}
+
+	return nil
+}
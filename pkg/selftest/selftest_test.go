@@ -0,0 +1,45 @@
+package selftest_test
+
+import (
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	"github.com/grtl/mysql-operator/pkg/selftest"
+)
+
+var _ = Describe("Run", func() {
+	logrus.SetOutput(ioutil.Discard)
+
+	It("passes the full lifecycle against the fake clientsets", func() {
+		err := selftest.Run(
+			fake.NewSimpleClientset(),
+			kubeFake.NewSimpleClientset(),
+			metav1.NamespaceDefault,
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("cleans up the resources it created", func() {
+		kubeClientset := kubeFake.NewSimpleClientset()
+		err := selftest.Run(fake.NewSimpleClientset(), kubeClientset, metav1.NamespaceDefault)
+		Expect(err).NotTo(HaveOccurred())
+
+		statefulSets, err := kubeClientset.AppsV1().
+			StatefulSets(metav1.NamespaceDefault).List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statefulSets.Items).To(BeEmpty())
+
+		services, err := kubeClientset.CoreV1().
+			Services(metav1.NamespaceDefault).List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services.Items).To(BeEmpty())
+	})
+})
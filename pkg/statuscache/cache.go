@@ -0,0 +1,137 @@
+// Package statuscache provides a read-only, informer-backed view of cluster
+// statuses. Dashboards and controllers watching many clusters can read the
+// status from the local cache instead of polling the API server.
+package statuscache
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+	"github.com/grtl/mysql-operator/pkg/client/informers/externalversions"
+)
+
+// Cache is a read-only view of cluster statuses backed by a shared informer.
+// Run has to be called before the cache serves fresh data.
+type Cache struct {
+	informer cache.SharedIndexInformer
+
+	mutex         sync.Mutex
+	subscriptions map[string][]*subscription
+}
+
+// subscription is a single SubscribeClusterStatus channel.
+type subscription struct {
+	key string
+	ch  chan crv1.MySQLClusterStatus
+}
+
+// New returns a status cache watching the clusters via a shared informer.
+func New(clientset versioned.Interface, resync time.Duration) *Cache {
+	factory := externalversions.NewSharedInformerFactory(clientset, resync)
+	statusCache := &Cache{
+		informer:      factory.Cr().V1().MySQLClusters().Informer(),
+		subscriptions: map[string][]*subscription{},
+	}
+
+	statusCache.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			statusCache.notify(obj.(*crv1.MySQLCluster))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCluster := oldObj.(*crv1.MySQLCluster)
+			newCluster := newObj.(*crv1.MySQLCluster)
+			// Subscribers only care about the status - spec-only updates
+			// and resyncs are not worth a notification.
+			if reflect.DeepEqual(oldCluster.Status, newCluster.Status) {
+				return
+			}
+			statusCache.notify(newCluster)
+		},
+	})
+
+	return statusCache
+}
+
+// Run runs the backing informer until the context is canceled.
+func (c *Cache) Run(ctx context.Context) {
+	c.informer.Run(ctx.Done())
+}
+
+// WaitForSync blocks until the informer has listed the existing clusters, or
+// the context is canceled. It returns whether the cache is synced.
+func (c *Cache) WaitForSync(ctx context.Context) bool {
+	return cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced)
+}
+
+// ClusterStatus returns the cached status of the named cluster. The second
+// return value is false when the cluster is not in the cache.
+func (c *Cache) ClusterStatus(namespace, name string) (crv1.MySQLClusterStatus, bool) {
+	obj, exists, err := c.informer.GetStore().GetByKey(statusKey(namespace, name))
+	if err != nil || !exists {
+		return crv1.MySQLClusterStatus{}, false
+	}
+	return obj.(*crv1.MySQLCluster).Status, true
+}
+
+// SubscribeClusterStatus returns a channel delivering the named cluster's
+// status changes, starting with the currently cached status when the cluster
+// is already known. The cancel function removes the subscription and closes
+// the channel.
+func (c *Cache) SubscribeClusterStatus(namespace, name string) (<-chan crv1.MySQLClusterStatus, func()) {
+	sub := &subscription{
+		key: statusKey(namespace, name),
+		ch:  make(chan crv1.MySQLClusterStatus, 1),
+	}
+
+	c.mutex.Lock()
+	c.subscriptions[sub.key] = append(c.subscriptions[sub.key], sub)
+	if status, ok := c.ClusterStatus(namespace, name); ok {
+		sub.ch <- status
+	}
+	c.mutex.Unlock()
+
+	cancel := func() {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		subs := c.subscriptions[sub.key]
+		for i := range subs {
+			if subs[i] == sub {
+				c.subscriptions[sub.key] = append(subs[:i], subs[i+1:]...)
+				break
+			}
+		}
+		if len(c.subscriptions[sub.key]) == 0 {
+			delete(c.subscriptions, sub.key)
+		}
+		close(sub.ch)
+	}
+
+	return sub.ch, cancel
+}
+
+// notify pushes the cluster's status to its subscribers. A slow subscriber
+// only ever sees the latest status - a value it has not read yet is replaced
+// rather than queued.
+func (c *Cache) notify(cluster *crv1.MySQLCluster) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, sub := range c.subscriptions[statusKey(cluster.Namespace, cluster.Name)] {
+		select {
+		case <-sub.ch:
+		default:
+		}
+		sub.ch <- cluster.Status
+	}
+}
+
+// statusKey is the informer store key of the namespaced cluster.
+func statusKey(namespace, name string) string {
+	return namespace + "/" + name
+}
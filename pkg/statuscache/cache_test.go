@@ -0,0 +1,90 @@
+package statuscache_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	"github.com/grtl/mysql-operator/pkg/statuscache"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Status Cache", func() {
+	var (
+		cluster    *crv1.MySQLCluster
+		clientset  *fake.Clientset
+		cache      *statuscache.Cache
+		cancelFunc context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+		cluster.Status.State = "Running"
+
+		clientset = fake.NewSimpleClientset(cluster)
+		cache = statuscache.New(clientset, 0)
+
+		var ctx context.Context
+		ctx, cancelFunc = context.WithCancel(context.Background())
+		go cache.Run(ctx)
+		Expect(cache.WaitForSync(ctx)).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		cancelFunc()
+	})
+
+	It("serves the cached status", func() {
+		status, ok := cache.ClusterStatus(cluster.Namespace, cluster.Name)
+		Expect(ok).To(BeTrue())
+		Expect(status.State).To(Equal("Running"))
+	})
+
+	It("reports an unknown cluster", func() {
+		_, ok := cache.ClusterStatus(cluster.Namespace, "no-such-cluster")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("delivers the current status to a new subscriber", func() {
+		statusChan, cancel := cache.SubscribeClusterStatus(cluster.Namespace, cluster.Name)
+		defer cancel()
+
+		var status crv1.MySQLClusterStatus
+		Eventually(statusChan).Should(Receive(&status))
+		Expect(status.State).To(Equal("Running"))
+	})
+
+	It("notifies subscribers about status changes", func() {
+		statusChan, cancel := cache.SubscribeClusterStatus(cluster.Namespace, cluster.Name)
+		defer cancel()
+
+		// Drain the initial status.
+		Eventually(statusChan).Should(Receive())
+
+		updated := cluster.DeepCopy()
+		updated.Status.State = "Degraded"
+		_, err := clientset.CrV1().MySQLClusters(cluster.Namespace).Update(updated)
+		Expect(err).NotTo(HaveOccurred())
+
+		var status crv1.MySQLClusterStatus
+		Eventually(statusChan).Should(Receive(&status))
+		Expect(status.State).To(Equal("Degraded"))
+	})
+
+	It("closes the channel when the subscription is canceled", func() {
+		statusChan, cancel := cache.SubscribeClusterStatus(cluster.Namespace, cluster.Name)
+		cancel()
+
+		Eventually(statusChan).Should(BeClosed())
+	})
+})
@@ -2,6 +2,7 @@ package factory
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/nauyey/factory/def"
@@ -12,7 +13,8 @@ import (
 // MySQLBackupInstanceFactory generates a Backup instance schedule with random data for testing.
 var MySQLBackupInstanceFactory = def.NewFactory(crv1.MySQLBackupInstance{}, "",
 	def.DynamicField("Spec.Schedule", func(model interface{}) (interface{}, error) {
-		return fmt.Sprintf("backup-%s", randomdata.RandStringRunes(16)), nil
+		// Backup names must be valid DNS labels, so keep them lowercase.
+		return fmt.Sprintf("backup-%s", strings.ToLower(randomdata.RandStringRunes(16))), nil
 	}),
 	def.Field("Status.Phase", crv1.MySQLBackupScheduled),
 	def.Field("ObjectMeta.Namespace", "default"),
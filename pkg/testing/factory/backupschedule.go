@@ -2,6 +2,7 @@ package factory
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/nauyey/factory/def"
@@ -13,17 +14,18 @@ import (
 // MySQLBackupScheduleFactory generates a backupschedule schedule with random data for testing.
 var MySQLBackupScheduleFactory = def.NewFactory(crv1.MySQLBackupSchedule{}, "",
 	def.DynamicField("ObjectMeta.Name", func(model interface{}) (interface{}, error) {
-		return fmt.Sprintf("backup-%s", randomdata.RandStringRunes(16)), nil
+		// Schedule names must be valid DNS labels, so keep them lowercase.
+		return fmt.Sprintf("backup-%s", strings.ToLower(randomdata.RandStringRunes(16))), nil
 	}),
 	def.Field("ObjectMeta.Namespace", "default"),
 	def.DynamicField("Spec.Time", func(model interface{}) (interface{}, error) {
+		// The CronJob controller accepts exactly the five standard fields.
 		minute := randomAny(randomdata.Number(0, 59))
 		hour := randomAny(randomdata.Number(0, 23))
 		day := randomAny(randomdata.Number(1, 31))
 		month := randomAny(randomdata.Number(1, 12))
-		weekday := randomAny(randomdata.Number(1, 7))
-		year := randomAny(randomdata.Number(1900, 3000))
-		return fmt.Sprintf("%s %s %s %s %s %s", minute, hour, day, month, weekday, year), nil
+		weekday := randomAny(randomdata.Number(0, 7))
+		return fmt.Sprintf("%s %s %s %s %s", minute, hour, day, month, weekday), nil
 	}),
 	def.Trait("ChangeDefaults",
 		def.Field("Spec.Storage", resource.MustParse("1Gi")),
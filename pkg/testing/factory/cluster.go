@@ -2,6 +2,7 @@ package factory
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/nauyey/factory/def"
@@ -13,7 +14,8 @@ import (
 // MySQLClusterFactory generates cluster with random data for testing.
 var MySQLClusterFactory = def.NewFactory(crv1.MySQLCluster{}, "",
 	def.DynamicField("ObjectMeta.Name", func(model interface{}) (interface{}, error) {
-		return fmt.Sprintf("cluster-%s", randomdata.RandStringRunes(16)), nil
+		// Cluster names must be valid DNS labels, so keep them lowercase.
+		return fmt.Sprintf("cluster-%s", strings.ToLower(randomdata.RandStringRunes(16))), nil
 	}),
 	def.Field("ObjectMeta.Namespace", "default"),
 	def.DynamicField("Spec.Secret", func(model interface{}) (interface{}, error) {
@@ -0,0 +1,88 @@
+// Package tracing emits spans around reconcile operations, so slow
+// reconciles can be broken down step by step. The span model follows
+// OpenTelemetry - a named span with attributes, timestamps and a recorded
+// error - and spans are exported as their JSON rendering to a configurable
+// HTTP endpoint, e.g. an OpenTelemetry collector. Tracing is a no-op unless
+// an endpoint is configured.
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/grtl/mysql-operator/pkg/config"
+)
+
+// Span is a single traced operation. A nil Span is a valid no-op, so call
+// sites do not have to check whether tracing is enabled.
+type Span struct {
+	// Name is the traced operation, e.g. AddCluster.
+	Name string `json:"name"`
+	// Attributes qualify the operation, e.g. the cluster name.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// StartTime and EndTime delimit the operation.
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Error is the error the operation ended with, when it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// StartSpan starts a span for the named operation. It returns nil - a no-op
+// span - when no trace endpoint is configured.
+func StartSpan(name string) *Span {
+	if config.Get().TraceEndpoint == "" {
+		return nil
+	}
+	return &Span{
+		Name:       name,
+		Attributes: map[string]string{},
+		StartTime:  time.Now(),
+	}
+}
+
+// SetAttribute records an attribute on the span.
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+	s.Attributes[key] = value
+}
+
+// RecordError records the error the operation ended with. A nil error is
+// ignored, so the call site can record its return value unconditionally.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.Error = err.Error()
+}
+
+// End finishes the span and exports it in the background. Exporting is best
+// effort - a lost span never fails the traced operation.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.EndTime = time.Now()
+	go export(s)
+}
+
+// export posts the span to the configured trace endpoint.
+func export(span *Span) {
+	payload, err := json.Marshal(span)
+	if err != nil {
+		return
+	}
+
+	response, err := http.Post(
+		config.Get().TraceEndpoint, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		logrus.WithField("fail", err).Debug("Unable to export the trace span")
+		return
+	}
+	response.Body.Close()
+}
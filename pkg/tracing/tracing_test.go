@@ -0,0 +1,36 @@
+package tracing_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"errors"
+
+	"github.com/grtl/mysql-operator/pkg/tracing"
+)
+
+var _ = Describe("Span", func() {
+	It("is a safe no-op when nil", func() {
+		var span *tracing.Span
+		Expect(func() {
+			span.SetAttribute("cluster", "my-cluster")
+			span.RecordError(errors.New("fail"))
+			span.End()
+		}).NotTo(Panic())
+	})
+
+	It("records attributes and errors", func() {
+		span := &tracing.Span{Name: "AddCluster", Attributes: map[string]string{}}
+		span.SetAttribute("cluster", "my-cluster")
+		span.RecordError(nil)
+		Expect(span.Error).To(BeEmpty())
+
+		span.RecordError(errors.New("create failed"))
+		Expect(span.Attributes).To(HaveKeyWithValue("cluster", "my-cluster"))
+		Expect(span.Error).To(Equal("create failed"))
+	})
+
+	It("is disabled without a configured endpoint", func() {
+		Expect(tracing.StartSpan("AddCluster")).To(BeNil())
+	})
+})
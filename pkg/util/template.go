@@ -5,11 +5,15 @@ import (
 	"text/template"
 
 	"github.com/grtl/mysql-operator/artifacts"
+	"github.com/grtl/mysql-operator/pkg/config"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
 // ObjectFromTemplate executes Go template with given source object and
-// parses the result into the destination object structure.
+// parses the result into the destination object structure. The operator-wide
+// default annotations from the configuration are stamped on the result, so
+// every created object carries them.
 func ObjectFromTemplate(source interface{}, destination interface{}, templateFile string, funcMap template.FuncMap) error {
 	assetBytes, err := artifacts.Asset(templateFile)
 	if err != nil {
@@ -28,5 +32,37 @@ func ObjectFromTemplate(source interface{}, destination interface{}, templateFil
 		return err
 	}
 
-	return yaml.NewYAMLOrJSONDecoder(buffer, 64).Decode(destination)
+	err = yaml.NewYAMLOrJSONDecoder(buffer, 64).Decode(destination)
+	if err != nil {
+		return err
+	}
+
+	ApplyDefaultAnnotations(destination, config.Get().DefaultAnnotations)
+	return nil
+}
+
+// ApplyDefaultAnnotations sets the given default annotations on the object.
+// Annotations the object already carries win over the defaults, so the
+// template output stays authoritative.
+func ApplyDefaultAnnotations(destination interface{}, defaults map[string]string) {
+	if len(defaults) == 0 {
+		return
+	}
+
+	accessor, err := meta.Accessor(destination)
+	if err != nil {
+		// Not a Kubernetes object with metadata - nothing to annotate.
+		return
+	}
+
+	annotations := accessor.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for key, value := range defaults {
+		if _, ok := annotations[key]; !ok {
+			annotations[key] = value
+		}
+	}
+	accessor.SetAnnotations(annotations)
 }
@@ -2,8 +2,32 @@ package util_test
 
 import (
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/grtl/mysql-operator/pkg/util"
 )
 
 var _ = Describe("Template", func() {
+	Describe("ApplyDefaultAnnotations", func() {
+		It("stamps the defaults on the object", func() {
+			service := new(corev1.Service)
+			util.ApplyDefaultAnnotations(service, map[string]string{
+				"mesh-inject": "false",
+			})
+			Expect(service.Annotations).To(
+				HaveKeyWithValue("mesh-inject", "false"))
+		})
 
+		It("keeps an annotation the object already carries", func() {
+			service := new(corev1.Service)
+			service.Annotations = map[string]string{"mesh-inject": "true"}
+			util.ApplyDefaultAnnotations(service, map[string]string{
+				"mesh-inject": "false",
+			})
+			Expect(service.Annotations).To(
+				HaveKeyWithValue("mesh-inject", "true"))
+		})
+	})
 })
@@ -0,0 +1,123 @@
+// Package webhook serves a validating admission webhook for the operator's
+// custom resources, so a misconfigured spec is rejected at admission time
+// with a clear message instead of failing deep inside the reconcile.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	"github.com/grtl/mysql-operator/pkg/client/clientset/versioned"
+)
+
+// MaxReplicas is the largest replica count admission accepts. Each replica
+// claims a full data volume, so a larger count is almost certainly a typo.
+const MaxReplicas = 64
+
+// ClusterValidator validates MySQLCluster objects at admission time. It
+// implements http.Handler, so it can be mounted straight onto the webhook
+// server mux.
+type ClusterValidator struct {
+	clientset versioned.Interface
+}
+
+// NewClusterValidator returns a validating webhook handler for MySQLCluster
+// objects.
+func NewClusterValidator(clientset versioned.Interface) *ClusterValidator {
+	return &ClusterValidator{clientset: clientset}
+}
+
+// ServeHTTP decodes the admission review from the request body, reviews the
+// carried cluster and writes the review back with the response filled in.
+func (v *ClusterValidator) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	review := new(admissionv1beta1.AdmissionReview)
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(writer, "unparsable admission review", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = v.Review(review.Request)
+
+	response, err := json.Marshal(review)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Write(response)
+}
+
+// Review validates the cluster carried by the admission request and returns
+// the matching admission response.
+func (v *ClusterValidator) Review(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	cluster := new(crv1.MySQLCluster)
+	if err := json.Unmarshal(request.Object.Raw, cluster); err != nil {
+		// A malformed quantity, e.g. an unparsable storage size, surfaces
+		// here as a decode error.
+		return denied(request, fmt.Sprintf("unparsable MySQLCluster: %v", err))
+	}
+
+	if err := v.validate(cluster, request.Namespace); err != nil {
+		return denied(request, err.Error())
+	}
+
+	return &admissionv1beta1.AdmissionResponse{UID: request.UID, Allowed: true}
+}
+
+// validate runs the admission-time checks. The spec validations shared with
+// the reconcile path run on a defaulted copy, so an optional field left
+// empty does not fail admission.
+func (v *ClusterValidator) validate(cluster *crv1.MySQLCluster, namespace string) error {
+	if cluster.Spec.Replicas < 0 {
+		return fmt.Errorf(
+			"cluster %q has a negative replica count", cluster.Name)
+	}
+	if cluster.Spec.Replicas > MaxReplicas {
+		return fmt.Errorf(
+			"cluster %q asks for %d replicas, the supported maximum is %d",
+			cluster.Name, cluster.Spec.Replicas, MaxReplicas)
+	}
+
+	defaulted := cluster.DeepCopy()
+	defaulted.WithDefaults()
+	if err := defaulted.Validate(); err != nil {
+		return err
+	}
+
+	if defaulted.Spec.FromBackup != "" {
+		_, err := v.clientset.CrV1().MySQLBackupInstances(namespace).
+			Get(defaulted.Spec.FromBackup, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf(
+				"cluster %q references backup %q, which does not exist in namespace %q",
+				cluster.Name, defaulted.Spec.FromBackup, namespace)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// denied builds a deny response carrying the given message.
+func denied(request *admissionv1beta1.AdmissionRequest, message string) *admissionv1beta1.AdmissionResponse {
+	return &admissionv1beta1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: false,
+		Result:  &metav1.Status{Message: message},
+	}
+}
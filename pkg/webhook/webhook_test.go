@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/nauyey/factory"
+
+	crv1 "github.com/grtl/mysql-operator/pkg/apis/cr/v1"
+	versioned "github.com/grtl/mysql-operator/pkg/client/clientset/versioned/fake"
+	testingFactory "github.com/grtl/mysql-operator/pkg/testing/factory"
+)
+
+var _ = Describe("Cluster validator", func() {
+	var (
+		cluster   *crv1.MySQLCluster
+		validator *ClusterValidator
+	)
+
+	reviewFor := func(raw []byte) *admissionv1beta1.AdmissionRequest {
+		return &admissionv1beta1.AdmissionRequest{
+			UID:       "review-uid",
+			Namespace: metav1.NamespaceDefault,
+			Object:    runtime.RawExtension{Raw: raw},
+		}
+	}
+
+	reviewCluster := func() *admissionv1beta1.AdmissionResponse {
+		raw, err := json.Marshal(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		return validator.Review(reviewFor(raw))
+	}
+
+	BeforeEach(func() {
+		cluster = new(crv1.MySQLCluster)
+		err := factory.Build(testingFactory.MySQLClusterFactory).To(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Namespace = metav1.NamespaceDefault
+
+		validator = NewClusterValidator(versioned.NewSimpleClientset())
+	})
+
+	It("allows a valid cluster", func() {
+		response := reviewCluster()
+		Expect(response.Allowed).To(BeTrue())
+		Expect(string(response.UID)).To(Equal("review-uid"))
+	})
+
+	It("denies a negative replica count", func() {
+		cluster.Spec.Replicas = -1
+		response := reviewCluster()
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("negative replica count"))
+	})
+
+	It("denies a replica count beyond the maximum", func() {
+		cluster.Spec.Replicas = MaxReplicas + 1
+		response := reviewCluster()
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("supported maximum"))
+	})
+
+	It("denies an unknown version", func() {
+		cluster.Spec.Version = "4.1"
+		response := reviewCluster()
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("unknown version"))
+	})
+
+	It("denies an unparsable storage size", func() {
+		raw := []byte(`{"metadata": {"name": "my-cluster"}, "spec": {"storage": "lots"}}`)
+
+		response := validator.Review(reviewFor(raw))
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("unparsable MySQLCluster"))
+	})
+
+	It("denies a restore from a backup that does not exist", func() {
+		cluster.Spec.FromBackup = "no-such-backup"
+		response := reviewCluster()
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("does not exist"))
+	})
+
+	It("allows a restore from an existing backup", func() {
+		backup := new(crv1.MySQLBackupInstance)
+		err := factory.Build(testingFactory.MySQLBackupInstanceFactory).To(backup)
+		Expect(err).NotTo(HaveOccurred())
+		backup.Namespace = metav1.NamespaceDefault
+		validator = NewClusterValidator(versioned.NewSimpleClientset(backup))
+
+		cluster.Spec.FromBackup = backup.Name
+		Expect(reviewCluster().Allowed).To(BeTrue())
+	})
+
+	Describe("ServeHTTP", func() {
+		It("writes the review back with the response filled in", func() {
+			raw, err := json.Marshal(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			review := &admissionv1beta1.AdmissionReview{
+				Request: reviewFor(raw),
+			}
+			body, err := json.Marshal(review)
+			Expect(err).NotTo(HaveOccurred())
+
+			request := httptest.NewRequest("POST", "/validate", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+			validator.ServeHTTP(recorder, request)
+
+			Expect(recorder.Code).To(Equal(200))
+			returned := new(admissionv1beta1.AdmissionReview)
+			Expect(json.Unmarshal(recorder.Body.Bytes(), returned)).To(Succeed())
+			Expect(returned.Response).NotTo(BeNil())
+			Expect(returned.Response.Allowed).To(BeTrue())
+			Expect(returned.Response.UID).To(Equal(review.Request.UID))
+		})
+
+		It("rejects an unparsable review body", func() {
+			request := httptest.NewRequest("POST", "/validate", bytes.NewReader([]byte("not json")))
+			recorder := httptest.NewRecorder()
+			validator.ServeHTTP(recorder, request)
+
+			Expect(recorder.Code).To(Equal(400))
+		})
+	})
+})